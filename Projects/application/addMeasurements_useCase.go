@@ -0,0 +1,36 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type AddMeasurementsUseCase struct {
+	db           repository.ProjectRepository
+	measurements repository.MeasurementRepository
+}
+
+func NewAddMeasurementsUseCase(db repository.ProjectRepository, measurements repository.MeasurementRepository) *AddMeasurementsUseCase {
+	return &AddMeasurementsUseCase{db: db, measurements: measurements}
+}
+
+// Execute guarda un lote de puntos enviado por un dispositivo; si un punto es inválido no se guarda
+// ninguno. Devuelve el conteo de puntos del proyecto después de la inserción
+func (uc *AddMeasurementsUseCase) Execute(projectId int, measurements []entities.Measurement) (int, error) {
+	if err := services.ValidateMeasurementBatch(measurements); err != nil {
+		return 0, err
+	}
+	if _, err := uc.db.FindById(projectId); err != nil {
+		return 0, err
+	}
+
+	if err := uc.measurements.SaveBatch(projectId, measurements); err != nil {
+		return 0, err
+	}
+	project, err := uc.db.FindById(projectId)
+	if err != nil {
+		return 0, err
+	}
+	return project.PointCount, nil
+}
@@ -0,0 +1,37 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type AddProjectTagsUseCase struct {
+	db   repository.ProjectRepository
+	tags repository.TagRepository
+}
+
+func NewAddProjectTagsUseCase(db repository.ProjectRepository, tags repository.TagRepository) *AddProjectTagsUseCase {
+	return &AddProjectTagsUseCase{db: db, tags: tags}
+}
+
+// Execute asigna las etiquetas al proyecto, creándolas si no existen, y devuelve todas sus etiquetas
+func (uc *AddProjectTagsUseCase) Execute(projectId int, names []string) ([]entities.Tag, error) {
+	tags, err := services.NormalizeTags(names)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("%w: se requiere al menos una etiqueta", entities.ErrInvalidInput)
+	}
+	if _, err := uc.db.FindById(projectId); err != nil {
+		return nil, err
+	}
+
+	if err := uc.tags.AddToProject(projectId, tags); err != nil {
+		return nil, err
+	}
+	return uc.tags.FindByProject(projectId)
+}
@@ -0,0 +1,26 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+const defaultTagSuggestions = 10
+
+type AutocompleteTagsUseCase struct {
+	tags repository.TagRepository
+}
+
+func NewAutocompleteTagsUseCase(tags repository.TagRepository) *AutocompleteTagsUseCase {
+	return &AutocompleteTagsUseCase{tags: tags}
+}
+
+// Execute sugiere etiquetas que empiezan con el texto escrito, sin distinguir acentos ni mayúsculas.
+// Sin texto devuelve las etiquetas más usadas
+func (uc *AutocompleteTagsUseCase) Execute(prefix string, limit int) ([]entities.Tag, error) {
+	if limit <= 0 {
+		limit = defaultTagSuggestions
+	}
+	return uc.tags.Autocomplete(services.Slugify(prefix), clampLimit(limit))
+}
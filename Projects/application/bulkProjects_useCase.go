@@ -0,0 +1,176 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type BulkProjectsUseCase struct {
+	db         repository.ProjectRepository
+	bulk       repository.ProjectBulkRepository
+	categories repository.CategoryRepository
+	workflow   entities.ProjectStatusWorkflow
+	documents  repository.ProjectDocumentRepository
+	storage    services.IDocumentStorage
+}
+
+func NewBulkProjectsUseCase(db repository.ProjectRepository, bulk repository.ProjectBulkRepository, categories repository.CategoryRepository, workflow entities.ProjectStatusWorkflow, documents repository.ProjectDocumentRepository, storage services.IDocumentStorage) *BulkProjectsUseCase {
+	return &BulkProjectsUseCase{db: db, bulk: bulk, categories: categories, workflow: workflow, documents: documents, storage: storage}
+}
+
+// Execute valida la operación para cada proyecto (que exista, que userId sea su autor o un administrador
+// y, en los cambios de estado, que el flujo permita la transición) y la aplica a todos en una sola
+// transacción. Si algún proyecto no la admite no se modifica ninguno y el reporte indica el motivo
+func (uc *BulkProjectsUseCase) Execute(op entities.BulkOperation, userId int, isAdmin bool) (*entities.BulkReport, error) {
+	op.Action = strings.ToLower(strings.TrimSpace(op.Action))
+	if !services.IsBulkAction(op.Action) {
+		return nil, fmt.Errorf("%w: la acción %q no existe; se permiten delete, recategorize, retag, reassign y status", entities.ErrInvalidInput, op.Action)
+	}
+	ids, err := services.NormalizeBulkIds(op.ProjectIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var category entities.Project
+	var addTags []entities.Tag
+	var removeSlugs []string
+	switch op.Action {
+	case entities.BulkActionRecategorize:
+		category = entities.Project{CategoryId: op.CategoryId, Categoria: op.Categoria}
+		if err := resolveProjectCategory(uc.categories, &category); err != nil {
+			return nil, err
+		}
+	case entities.BulkActionRetag:
+		if addTags, removeSlugs, err = normalizeBulkTags(op.AddTags, op.RemoveTags); err != nil {
+			return nil, err
+		}
+	case entities.BulkActionReassign:
+		if op.UserId <= 0 {
+			return nil, fmt.Errorf("%w: el userId debe ser un número mayor a 0", entities.ErrInvalidInput)
+		}
+	case entities.BulkActionStatus:
+		op.Status = strings.ToLower(strings.TrimSpace(op.Status))
+		if !services.IsProjectStatus(op.Status) {
+			return nil, fmt.Errorf("%w: el estado %q no existe", entities.ErrInvalidInput, op.Status)
+		}
+		op.Comment = strings.TrimSpace(op.Comment)
+		if utf8.RuneCountInString(op.Comment) > services.MaxStatusCommentLength {
+			return nil, fmt.Errorf("%w: el comentario admite como máximo %d caracteres", entities.ErrInvalidInput, services.MaxStatusCommentLength)
+		}
+	}
+
+	report := &entities.BulkReport{
+		Action:  op.Action,
+		Total:   len(ids),
+		Results: make([]entities.BulkItemResult, 0, len(ids)),
+	}
+	projects := make([]entities.Project, 0, len(ids))
+	for _, id := range ids {
+		result := entities.BulkItemResult{ProjectId: id}
+		project, err := uc.db.FindById(id)
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			err = fmt.Errorf("el proyecto no existe")
+		case err != nil:
+			return nil, err
+		case !services.CanModifyProject(*project, userId, isAdmin):
+			err = fmt.Errorf("el proyecto pertenece a otro usuario")
+		case op.Action == entities.BulkActionStatus:
+			err = services.CheckStatusTransition(uc.workflow, project.Status, op.Status)
+		}
+
+		if err != nil {
+			result.Error = err.Error()
+			report.Failed++
+		} else {
+			result.Success = true
+			report.Succeeded++
+			projects = append(projects, *project)
+		}
+		report.Results = append(report.Results, result)
+	}
+	if report.Failed > 0 {
+		log.Printf("INFO: Operación masiva %s rechazada - Total: %d, Fallidos: %d", op.Action, report.Total, report.Failed)
+		return report, nil
+	}
+
+	targets := make([]entities.BulkTarget, len(projects))
+	for i, project := range projects {
+		targets[i] = entities.BulkTarget{Id: project.Id, Version: project.Version}
+	}
+
+	// Los cambios de campos editables quedan en el historial de cada proyecto, igual que una edición individual
+	change := entities.ProjectRevision{Action: entities.RevisionActionUpdate, EditorId: userId}
+	switch op.Action {
+	case entities.BulkActionDelete:
+		// Los archivos de los documentos adjuntos se eliminan solo si se eliminaron los proyectos
+		var locations []string
+		if locations, err = projectDocumentLocations(uc.documents, ids...); err != nil {
+			return nil, err
+		}
+		if err = uc.bulk.DeleteProjects(targets); err == nil {
+			removeDocumentFiles(uc.storage, locations)
+		}
+	case entities.BulkActionRecategorize:
+		err = uc.bulk.UpdateCategory(targets, category.CategoryId, category.Categoria, change)
+	case entities.BulkActionRetag:
+		err = uc.bulk.ChangeTags(ids, addTags, removeSlugs)
+	case entities.BulkActionReassign:
+		err = uc.bulk.UpdateOwner(targets, op.UserId, change)
+	case entities.BulkActionStatus:
+		transitions := make([]entities.ProjectStatusTransition, len(projects))
+		for i, project := range projects {
+			transitions[i] = entities.ProjectStatusTransition{
+				ProjectId:  project.Id,
+				FromStatus: project.Status,
+				ToStatus:   op.Status,
+				Comment:    op.Comment,
+				UserId:     userId,
+			}
+		}
+		err = uc.bulk.ChangeStatuses(targets, transitions)
+	}
+	if err != nil {
+		return nil, err
+	}
+	report.Applied = true
+
+	log.Printf("INFO: Operación masiva %s aplicada a %d proyectos", op.Action, report.Total)
+	return report, nil
+}
+
+// normalizeBulkTags valida las etiquetas que se asignan y las que se quitan; se requiere al menos una
+func normalizeBulkTags(add, remove []string) ([]entities.Tag, []string, error) {
+	addTags, err := services.NormalizeTags(add)
+	if err != nil {
+		return nil, nil, err
+	}
+	removeTags, err := services.NormalizeTags(remove)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(addTags) == 0 && len(removeTags) == 0 {
+		return nil, nil, fmt.Errorf("%w: se requiere al menos una etiqueta en addTags o removeTags", entities.ErrInvalidInput)
+	}
+	if len(addTags)+len(removeTags) > services.MaxTagsPerRequest {
+		return nil, nil, fmt.Errorf("%w: se permiten como máximo %d etiquetas por operación", entities.ErrInvalidInput, services.MaxTagsPerRequest)
+	}
+
+	removeSlugs := make([]string, 0, len(removeTags))
+	for _, tag := range removeTags {
+		for _, added := range addTags {
+			if added.Slug == tag.Slug {
+				return nil, nil, fmt.Errorf("%w: la etiqueta %q no se puede asignar y quitar a la vez", entities.ErrInvalidInput, tag.Nombre)
+			}
+		}
+		removeSlugs = append(removeSlugs, tag.Slug)
+	}
+	return addTags, removeSlugs, nil
+}
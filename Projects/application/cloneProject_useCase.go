@@ -0,0 +1,124 @@
+package application
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// clonePointsPage es el tamaño de página con el que se leen los puntos del proyecto original
+const clonePointsPage = 1000
+
+type CloneProjectUseCase struct {
+	db         repository.ProjectRepository
+	tags       repository.TagRepository
+	points     repository.MeasurementRepository
+	media      repository.ProjectMediaRepository
+	geocodeSrv *services.GeocodingWorkerService
+}
+
+func NewCloneProjectUseCase(db repository.ProjectRepository, tags repository.TagRepository, points repository.MeasurementRepository, media repository.ProjectMediaRepository, geocodeSrv *services.GeocodingWorkerService) *CloneProjectUseCase {
+	return &CloneProjectUseCase{db: db, tags: tags, points: points, media: media, geocodeSrv: geocodeSrv}
+}
+
+// Execute crea un proyecto nuevo a partir de otro: copia sus campos, geometría, etiquetas y puntos de
+// medición y, si options.IncludeImages, su galería (las imágenes no se vuelven a subir, se comparten).
+// La copia empieza como borrador con la fecha actual y la lista de verificación pendiente. Si algo falla
+// al copiar se elimina el proyecto a medio crear
+func (uc *CloneProjectUseCase) Execute(sourceId int, options entities.CloneOptions) (*entities.Project, error) {
+	source, err := uc.db.FindById(sourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := *source
+	clone.Id, clone.Version, clone.PointCount = 0, 0, 0
+	clone.Status = entities.StatusDraft
+	clone.Fecha = time.Now().UTC()
+	clone.Address = nil
+	clone.NombreProyecto = options.NombreProyecto
+	if clone.NombreProyecto == "" {
+		clone.NombreProyecto = source.NombreProyecto + " (copia)"
+	}
+	if options.UserId > 0 {
+		clone.UserId = options.UserId
+	}
+	if !options.IncludeImages {
+		clone.Img = ""
+	}
+	clone.Checklist = make([]entities.ChecklistItem, 0, len(source.Checklist))
+	for _, item := range source.Checklist {
+		clone.Checklist = append(clone.Checklist, entities.ChecklistItem{Text: item.Text})
+	}
+	if err := validateProjectFields(clone); err != nil {
+		return nil, err
+	}
+
+	id, err := uc.db.Save(clone, entities.ProjectRevision{Action: entities.RevisionActionCreate, EditorId: clone.UserId})
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.copyRelated(sourceId, id, clone.UserId, options.IncludeImages); err != nil {
+		if deleteErr := uc.db.Delete(id); deleteErr != nil {
+			log.Printf("ERROR: No se pudo eliminar la copia incompleta %d: %v", id, deleteErr)
+		}
+		return nil, fmt.Errorf("error al copiar el proyecto %d: %w", sourceId, err)
+	}
+
+	saved, err := uc.db.FindById(id)
+	if err != nil {
+		return nil, err
+	}
+	requestGeocoding(uc.geocodeSrv, nil, *saved)
+
+	log.Printf("SUCCESS: Proyecto %d clonado como %d", sourceId, id)
+	return saved, nil
+}
+
+// copyRelated copia las etiquetas, los puntos de medición y opcionalmente la galería del proyecto original;
+// editorId es el dueño de la copia
+func (uc *CloneProjectUseCase) copyRelated(sourceId, targetId, editorId int, includeImages bool) error {
+	tags, err := uc.tags.FindByProject(sourceId)
+	if err != nil {
+		return err
+	}
+	if len(tags) > 0 {
+		if err := uc.tags.AddToProject(targetId, tags); err != nil {
+			return err
+		}
+	}
+
+	var points []entities.Measurement
+	for afterId := 0; ; {
+		page, err := uc.points.FindByProject(sourceId, afterId, clonePointsPage)
+		if err != nil {
+			return err
+		}
+		points = append(points, page...)
+		if len(page) < clonePointsPage {
+			break
+		}
+		afterId = page[len(page)-1].Id
+	}
+	if len(points) > 0 {
+		if err := uc.points.SaveBatch(targetId, points); err != nil {
+			return err
+		}
+	}
+
+	if !includeImages {
+		return nil
+	}
+	gallery, err := uc.media.FindByProject(sourceId)
+	if err != nil {
+		return err
+	}
+	if len(gallery) > 0 {
+		return uc.media.Add(targetId, gallery, editorId)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type CreateCategoryUseCase struct {
+	categories repository.CategoryRepository
+}
+
+func NewCreateCategoryUseCase(categories repository.CategoryRepository) *CreateCategoryUseCase {
+	return &CreateCategoryUseCase{categories: categories}
+}
+
+func (uc *CreateCategoryUseCase) Execute(category entities.Category) (*entities.Category, error) {
+	if err := services.ValidateCategory(&category); err != nil {
+		return nil, err
+	}
+	if err := ensureSlugAvailable(uc.categories, category.Slug, 0); err != nil {
+		return nil, err
+	}
+
+	id, err := uc.categories.Save(category)
+	if err != nil {
+		return nil, err
+	}
+	return uc.categories.FindById(id)
+}
+
+// ensureSlugAvailable comprueba que ninguna otra categoría use el slug; exceptId permite conservar el propio
+func ensureSlugAvailable(categories repository.CategoryRepository, slug string, exceptId int) error {
+	existing, err := categories.FindBySlug(slug)
+	if errors.Is(err, entities.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if existing.Id != exceptId {
+		return fmt.Errorf("%w: ya existe una categoría con el slug %q", entities.ErrConflict, slug)
+	}
+	return nil
+}
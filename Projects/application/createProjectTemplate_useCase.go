@@ -0,0 +1,32 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type CreateProjectTemplateUseCase struct {
+	templates  repository.ProjectTemplateRepository
+	categories repository.CategoryRepository
+}
+
+func NewCreateProjectTemplateUseCase(templates repository.ProjectTemplateRepository, categories repository.CategoryRepository) *CreateProjectTemplateUseCase {
+	return &CreateProjectTemplateUseCase{templates: templates, categories: categories}
+}
+
+// Execute valida y guarda la plantilla; la categoría debe existir en el catálogo
+func (uc *CreateProjectTemplateUseCase) Execute(template entities.ProjectTemplate) (*entities.ProjectTemplate, error) {
+	if err := services.ValidateProjectTemplate(&template); err != nil {
+		return nil, err
+	}
+	if err := resolveTemplateCategory(uc.categories, &template); err != nil {
+		return nil, err
+	}
+
+	id, err := uc.templates.Save(template)
+	if err != nil {
+		return nil, err
+	}
+	return uc.templates.FindById(id)
+}
@@ -1,6 +1,7 @@
 package application
 
 import (
+	"fmt"
 	"log"
 	"net"
 	"strings"
@@ -12,9 +13,13 @@ import (
 )
 
 type CreateProjectUseCase struct {
-	db        repository.ProjectRepository
-	cloudSrv  services.ICloudinaryService
-	workerSrv *services.ImageUploadWorkerService
+	db         repository.ProjectRepository
+	categories repository.CategoryRepository
+	media      repository.ProjectMediaRepository
+	templates  repository.ProjectTemplateRepository
+	cloudSrv   services.ICloudinaryService
+	workerSrv  *services.ImageUploadWorkerService
+	geocodeSrv *services.GeocodingWorkerService
 }
 
 type ProjectCreationResult struct {
@@ -22,13 +27,18 @@ type ProjectCreationResult struct {
 	Message   string `json:"message"`
 	IsOffline bool   `json:"is_offline"`
 	HasImage  bool   `json:"has_image"`
+	ProjectId int    `json:"project_id,omitempty"`
 }
 
-func NewCreateProjectUseCase(db repository.ProjectRepository, cloudSrv services.ICloudinaryService, workerSrv *services.ImageUploadWorkerService) *CreateProjectUseCase {
+func NewCreateProjectUseCase(db repository.ProjectRepository, categories repository.CategoryRepository, media repository.ProjectMediaRepository, templates repository.ProjectTemplateRepository, cloudSrv services.ICloudinaryService, workerSrv *services.ImageUploadWorkerService, geocodeSrv *services.GeocodingWorkerService) *CreateProjectUseCase {
 	return &CreateProjectUseCase{
-		db:        db,
-		cloudSrv:  cloudSrv,
-		workerSrv: workerSrv,
+		db:         db,
+		categories: categories,
+		media:      media,
+		templates:  templates,
+		cloudSrv:   cloudSrv,
+		workerSrv:  workerSrv,
+		geocodeSrv: geocodeSrv,
 	}
 }
 
@@ -61,11 +71,33 @@ func (uc *CreateProjectUseCase) isConnectivityError(err error) bool {
 	return false
 }
 
-func (uc *CreateProjectUseCase) Execute(project entities.Project, imagePath string) (*ProjectCreationResult, error) {
+// Execute crea el proyecto. imagePath es la imagen principal (opcional) y media las imágenes de la
+// galería; la imagen principal, o la primera de la galería si no hay, queda como portada.
+// Con project.TemplateId la plantilla completa la categoría, la descripción y la lista de verificación.
+// Si el proyecto o su galería no se pueden guardar, la creación falla y las imágenes subidas se eliminan
+func (uc *CreateProjectUseCase) Execute(project entities.Project, imagePath string, media []entities.MediaUpload) (*ProjectCreationResult, error) {
 	result := &ProjectCreationResult{
 		Success:   false,
 		IsOffline: false,
-		HasImage:  imagePath != "",
+		HasImage:  imagePath != "" || len(media) > 0,
+	}
+
+	// Todo proyecto nace como borrador; el estado solo cambia con una transición
+	project.Status = entities.StatusDraft
+	if err := applyProjectTemplate(uc.templates, &project); err != nil {
+		return result, err
+	}
+	if err := resolveProjectCategory(uc.categories, &project); err != nil {
+		return result, err
+	}
+	if err := applyProjectGeometry(&project); err != nil {
+		return result, err
+	}
+	if err := validateProjectFields(project); err != nil {
+		return result, err
+	}
+	if err := services.ValidateMediaUploads(media); err != nil {
+		return result, err
 	}
 
 	hasInternet := uc.hasInternetConnection()
@@ -103,19 +135,68 @@ func (uc *CreateProjectUseCase) Execute(project entities.Project, imagePath stri
 			project.Img = ""
 			result.Message = "Proyecto creado sin imagen debido a falta de conexión a internet."
 		}
-	} else {
+	} else if len(media) == 0 {
 
 		project.Img = ""
 		result.Message = "Proyecto creado exitosamente sin imagen"
 		log.Println("INFO: Proyecto creado sin imagen (no se proporcionó archivo)")
 	}
 
-	if err := uc.db.Save(project); err != nil {
+	var gallery []entities.ProjectMedia
+	if project.Img != "" {
+		gallery = append(gallery, entities.ProjectMedia{Url: project.Img, IsCover: true})
+	}
+	if len(media) > 0 {
+		if hasInternet && !result.IsOffline {
+			uploaded, err := uploadProjectMedia(uc.workerSrv, media)
+			if err != nil {
+				if !uc.isConnectivityError(err) {
+					log.Printf("ERROR: Error al subir la galería (no conectividad): %v", err)
+					return result, err
+				}
+				log.Printf("WARNING: Error de conectividad detectado al subir la galería: %v", err)
+				result.IsOffline = true
+				result.Message = "Proyecto creado sin galería debido a problemas de conectividad."
+			} else {
+				if project.Img == "" && len(uploaded) > 0 {
+					// La primera imagen de la galería es la portada desde la revisión de creación
+					uploaded[0].IsCover = true
+					project.Img = uploaded[0].Url
+				}
+				gallery = append(gallery, uploaded...)
+				if imagePath == "" {
+					result.Message = fmt.Sprintf("Proyecto creado exitosamente con %d imágenes", len(uploaded))
+				}
+			}
+		} else if imagePath == "" {
+			log.Println("WARNING: Sin conectividad a internet, creando proyecto sin galería")
+			result.IsOffline = true
+			result.Message = "Proyecto creado sin imágenes debido a falta de conexión a internet."
+		}
+	}
+
+	id, err := uc.db.Save(project, entities.ProjectRevision{Action: entities.RevisionActionCreate, EditorId: project.UserId})
+	if err != nil {
 		log.Printf("ERROR: Error al guardar proyecto en BD: %v", err)
+		discardUploads(uc.workerSrv, gallery)
 		return result, err
 	}
+	project.Id = id
+
+	if len(gallery) > 0 {
+		if err := uc.media.Add(id, gallery, project.UserId); err != nil {
+			log.Printf("ERROR: No se pudo guardar la galería del proyecto %d: %v", id, err)
+			if delErr := uc.db.Delete(id); delErr != nil {
+				log.Printf("ERROR: No se pudo eliminar el proyecto %d sin galería: %v", id, delErr)
+			}
+			discardUploads(uc.workerSrv, gallery)
+			return result, err
+		}
+	}
+	requestGeocoding(uc.geocodeSrv, nil, project)
 
 	result.Success = true
+	result.ProjectId = id
 	log.Printf("SUCCESS: Proyecto creado - ID: %d, Offline: %t, HasImage: %t",
 		project.Id, result.IsOffline, result.HasImage)
 
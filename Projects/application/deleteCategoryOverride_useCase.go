@@ -0,0 +1,18 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type DeleteCategoryOverrideUseCase struct {
+	categories repository.CategoryRepository
+}
+
+func NewDeleteCategoryOverrideUseCase(categories repository.CategoryRepository) *DeleteCategoryOverrideUseCase {
+	return &DeleteCategoryOverrideUseCase{categories: categories}
+}
+
+// Execute quita la personalización, con lo que la organización vuelve a ver la categoría global
+func (uc *DeleteCategoryOverrideUseCase) Execute(categoryId, orgId int) error {
+	return uc.categories.DeleteOverride(categoryId, orgId)
+}
@@ -0,0 +1,31 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type DeleteCategoryUseCase struct {
+	categories repository.CategoryRepository
+}
+
+func NewDeleteCategoryUseCase(categories repository.CategoryRepository) *DeleteCategoryUseCase {
+	return &DeleteCategoryUseCase{categories: categories}
+}
+
+// Execute elimina una categoría que ningún proyecto usa
+func (uc *DeleteCategoryUseCase) Execute(id int) error {
+	if _, err := uc.categories.FindById(id); err != nil {
+		return err
+	}
+	count, err := uc.categories.CountProjects(id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: la categoría tiene %d proyectos; reclasifíquelos antes de eliminarla", entities.ErrConflict, count)
+	}
+	return uc.categories.Delete(id)
+}
@@ -0,0 +1,17 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type DeleteMeasurementUseCase struct {
+	measurements repository.MeasurementRepository
+}
+
+func NewDeleteMeasurementUseCase(measurements repository.MeasurementRepository) *DeleteMeasurementUseCase {
+	return &DeleteMeasurementUseCase{measurements: measurements}
+}
+
+func (uc *DeleteMeasurementUseCase) Execute(projectId, measurementId int) error {
+	return uc.measurements.Delete(projectId, measurementId)
+}
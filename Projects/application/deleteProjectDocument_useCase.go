@@ -0,0 +1,33 @@
+package application
+
+import (
+	"log"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type DeleteProjectDocumentUseCase struct {
+	documents repository.ProjectDocumentRepository
+	storage   services.IDocumentStorage
+}
+
+func NewDeleteProjectDocumentUseCase(documents repository.ProjectDocumentRepository, storage services.IDocumentStorage) *DeleteProjectDocumentUseCase {
+	return &DeleteProjectDocumentUseCase{documents: documents, storage: storage}
+}
+
+// Execute elimina el registro y después el archivo; si el archivo no se puede borrar solo se registra,
+// porque el documento ya no es accesible desde la API
+func (uc *DeleteProjectDocumentUseCase) Execute(projectId, documentId int) error {
+	document, err := uc.documents.FindById(projectId, documentId)
+	if err != nil {
+		return err
+	}
+	if err := uc.documents.Delete(projectId, documentId); err != nil {
+		return err
+	}
+	if err := uc.storage.Delete(document.Location); err != nil {
+		log.Printf("WARNING: No se pudo eliminar el archivo del documento %d: %v", documentId, err)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type DeleteProjectMediaUseCase struct {
+	media repository.ProjectMediaRepository
+}
+
+func NewDeleteProjectMediaUseCase(media repository.ProjectMediaRepository) *DeleteProjectMediaUseCase {
+	return &DeleteProjectMediaUseCase{media: media}
+}
+
+// Execute quita la imagen de la galería; si era la portada, la siguiente imagen ocupa su lugar y el
+// cambio queda en el historial del proyecto a nombre de editorId
+func (uc *DeleteProjectMediaUseCase) Execute(projectId, mediaId, editorId int) error {
+	return uc.media.Delete(projectId, mediaId, editorId)
+}
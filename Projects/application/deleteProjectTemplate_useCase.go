@@ -0,0 +1,30 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type DeleteProjectTemplateUseCase struct {
+	templates repository.ProjectTemplateRepository
+}
+
+func NewDeleteProjectTemplateUseCase(templates repository.ProjectTemplateRepository) *DeleteProjectTemplateUseCase {
+	return &DeleteProjectTemplateUseCase{templates: templates}
+}
+
+// Execute elimina la plantilla si editorId es su autor o un administrador; los proyectos creados con
+// ella la dejan de referenciar
+func (uc *DeleteProjectTemplateUseCase) Execute(id, editorId int, isAdmin bool) error {
+	template, err := uc.templates.FindById(id)
+	if err != nil {
+		return err
+	}
+	if !services.CanModifyTemplate(*template, editorId, isAdmin) {
+		return fmt.Errorf("%w: la plantilla pertenece a otro usuario", entities.ErrForbidden)
+	}
+	return uc.templates.Delete(id)
+}
@@ -4,23 +4,33 @@ import (
 	"fmt"
 
 	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
 )
 
 type DeleleProjectUseCase struct {
-	db repository.ProjectRepository
+	db        repository.ProjectRepository
+	documents repository.ProjectDocumentRepository
+	storage   services.IDocumentStorage
 }
 
-func NewDeleteProjectUseCase (db repository.ProjectRepository) *DeleleProjectUseCase{
-	return &DeleleProjectUseCase{db: db}
+func NewDeleteProjectUseCase (db repository.ProjectRepository, documents repository.ProjectDocumentRepository, storage services.IDocumentStorage) *DeleleProjectUseCase{
+	return &DeleleProjectUseCase{db: db, documents: documents, storage: storage}
 }
 
+// Execute elimina el proyecto y después los archivos de sus documentos adjuntos
+
 func (dp *DeleleProjectUseCase) Execute(id int) error{
 	_, err := dp.db.FindById(id)
 	if err != nil {
 		return fmt.Errorf("Proyecto con el ID %d no encontrado: %w", id, err )
 	}
+	locations, err := projectDocumentLocations(dp.documents, id)
+	if err != nil {
+		return err
+	}
 	if err := dp.db.Delete(id); err != nil{
 		return fmt.Errorf("Error al eliminar el usuarios con ese ID %d: %w", id, err)
 	}
+	removeDocumentFiles(dp.storage, locations)
 	return nil
 }
\ No newline at end of file
@@ -0,0 +1,40 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type DiffProjectRevisionsUseCase struct {
+	revisions repository.ProjectRevisionRepository
+}
+
+func NewDiffProjectRevisionsUseCase(revisions repository.ProjectRevisionRepository) *DiffProjectRevisionsUseCase {
+	return &DiffProjectRevisionsUseCase{revisions: revisions}
+}
+
+// Execute compara campo por campo las copias del proyecto guardadas en dos revisiones
+func (uc *DiffProjectRevisionsUseCase) Execute(projectId, from, to int) (*entities.RevisionDiff, error) {
+	if from <= 0 || to <= 0 {
+		return nil, fmt.Errorf("%w: from y to deben ser números de revisión mayores a 0", entities.ErrInvalidFilter)
+	}
+
+	fromRevision, err := uc.revisions.FindRevision(projectId, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := uc.revisions.FindRevision(projectId, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.RevisionDiff{
+		ProjectId: projectId,
+		From:      from,
+		To:        to,
+		Changes:   services.DiffProjects(fromRevision.Snapshot, toRevision.Snapshot),
+	}, nil
+}
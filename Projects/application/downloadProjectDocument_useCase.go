@@ -0,0 +1,31 @@
+package application
+
+import (
+	"io"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type DownloadProjectDocumentUseCase struct {
+	documents repository.ProjectDocumentRepository
+	storage   services.IDocumentStorage
+}
+
+func NewDownloadProjectDocumentUseCase(documents repository.ProjectDocumentRepository, storage services.IDocumentStorage) *DownloadProjectDocumentUseCase {
+	return &DownloadProjectDocumentUseCase{documents: documents, storage: storage}
+}
+
+// Execute devuelve los datos del documento y su contenido; quien llama debe cerrar el contenido
+func (uc *DownloadProjectDocumentUseCase) Execute(projectId, documentId int) (*entities.ProjectDocument, io.ReadCloser, error) {
+	document, err := uc.documents.FindById(projectId, documentId)
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err := uc.storage.Open(document.Location)
+	if err != nil {
+		return nil, nil, err
+	}
+	return document, content, nil
+}
@@ -0,0 +1,33 @@
+package application
+
+import (
+	"io"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type ExportProjectsGPXUseCase struct {
+	db repository.ProjectRepository
+}
+
+func NewExportProjectsGPXUseCase(db repository.ProjectRepository) *ExportProjectsGPXUseCase {
+	return &ExportProjectsGPXUseCase{db: db}
+}
+
+// Execute escribe en w los proyectos filtrados como waypoints GPX
+func (uc *ExportProjectsGPXUseCase) Execute(filter entities.ProjectFilter, w io.Writer) error {
+	if err := normalizeProjectFilter(&filter); err != nil {
+		return err
+	}
+
+	gpx, err := services.NewGPXWriter(w)
+	if err != nil {
+		return err
+	}
+	if err := uc.db.StreamFiltered(filter, gpx.WriteProject); err != nil {
+		return err
+	}
+	return gpx.Close()
+}
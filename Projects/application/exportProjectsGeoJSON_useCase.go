@@ -0,0 +1,65 @@
+package application
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type ExportProjectsGeoJSONUseCase struct {
+	db repository.ProjectRepository
+}
+
+func NewExportProjectsGeoJSONUseCase(db repository.ProjectRepository) *ExportProjectsGeoJSONUseCase {
+	return &ExportProjectsGeoJSONUseCase{db: db}
+}
+
+// Execute escribe en w un FeatureCollection con todos los proyectos que cumplen el filtro.
+// Los Features se escriben a medida que se leen de la base de datos. Con un crs distinto de WGS84
+// las coordenadas se reproyectan y el FeatureCollection lleva el miembro crs de GeoJSON 2008
+func (uc *ExportProjectsGeoJSONUseCase) Execute(filter entities.ProjectFilter, crs int, w io.Writer) error {
+	if err := normalizeProjectFilter(&filter); err != nil {
+		return err
+	}
+
+	header := `{"type":"FeatureCollection",`
+	if crs != entities.CRSWGS84 {
+		member, err := json.Marshal(services.NamedGeoJSONCRS(crs))
+		if err != nil {
+			return err
+		}
+		header += `"crs":` + string(member) + `,`
+	}
+	if _, err := io.WriteString(w, header+`"features":[`); err != nil {
+		return err
+	}
+
+	first := true
+	err := uc.db.StreamFiltered(filter, func(project entities.Project) error {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		feature := services.ProjectToFeature(project)
+		if err := services.ReprojectGeoJSONGeometry(feature.Geometry, entities.CRSWGS84, crs); err != nil {
+			return err
+		}
+		encoded, err := json.Marshal(feature)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(encoded)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}")
+	return err
+}
@@ -0,0 +1,56 @@
+package application
+
+import (
+	"archive/zip"
+	"io"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type ExportProjectsKMLUseCase struct {
+	db         repository.ProjectRepository
+	categories repository.CategoryRepository
+}
+
+func NewExportProjectsKMLUseCase(db repository.ProjectRepository, categories repository.CategoryRepository) *ExportProjectsKMLUseCase {
+	return &ExportProjectsKMLUseCase{db: db, categories: categories}
+}
+
+// Execute escribe en w los proyectos filtrados como KML, o como KMZ (doc.kml comprimido) si compressed es true
+func (uc *ExportProjectsKMLUseCase) Execute(filter entities.ProjectFilter, w io.Writer, compressed bool) error {
+	if err := normalizeProjectFilter(&filter); err != nil {
+		return err
+	}
+	// Las categorías se cargan antes de escribir para declarar sus estilos en la cabecera
+	categories, err := uc.categories.FindAll()
+	if err != nil {
+		return err
+	}
+
+	if !compressed {
+		return uc.writeKML(filter, categories, w)
+	}
+
+	archive := zip.NewWriter(w)
+	doc, err := archive.Create("doc.kml")
+	if err != nil {
+		return err
+	}
+	if err := uc.writeKML(filter, categories, doc); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+func (uc *ExportProjectsKMLUseCase) writeKML(filter entities.ProjectFilter, categories []entities.Category, w io.Writer) error {
+	kml, err := services.NewKMLWriter(w, "Proyectos Geova", categories)
+	if err != nil {
+		return err
+	}
+	if err := uc.db.StreamFiltered(filter, kml.WriteProject); err != nil {
+		return err
+	}
+	return kml.Close()
+}
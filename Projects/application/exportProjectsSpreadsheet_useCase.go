@@ -0,0 +1,58 @@
+package application
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type ExportProjectsSpreadsheetUseCase struct {
+	db repository.ProjectRepository
+}
+
+func NewExportProjectsSpreadsheetUseCase(db repository.ProjectRepository) *ExportProjectsSpreadsheetUseCase {
+	return &ExportProjectsSpreadsheetUseCase{db: db}
+}
+
+// projectRowWriter es la parte común de los escritores de CSV y XLSX
+type projectRowWriter interface {
+	WriteProject(project entities.Project) error
+	Close() error
+}
+
+// Execute escribe en w los proyectos filtrados como CSV o XLSX. Los proyectos se leen y escriben de uno
+// en uno, por lo que la memoria no crece con la cantidad de filas
+func (uc *ExportProjectsSpreadsheetUseCase) Execute(filter entities.ProjectFilter, w io.Writer, export entities.SpreadsheetExport) error {
+	if err := normalizeProjectFilter(&filter); err != nil {
+		return err
+	}
+	columns, err := services.ParseProjectColumns(export.Columns)
+	if err != nil {
+		return err
+	}
+	lang, err := services.ParseExportLanguage(export.Language)
+	if err != nil {
+		return err
+	}
+
+	var rows projectRowWriter
+	switch export.Format {
+	case entities.SpreadsheetCSV:
+		rows, err = services.NewProjectCSVWriter(w, columns, lang, export.Location, export.Delimiter)
+	case entities.SpreadsheetXLSX:
+		rows, err = services.NewProjectXLSXWriter(w, columns, lang, export.Location)
+	default:
+		return fmt.Errorf("%w: formato de exportación %q no soportado", entities.ErrInvalidFilter, export.Format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := uc.db.StreamFiltered(filter, rows.WriteProject); err != nil {
+		return err
+	}
+	return rows.Close()
+}
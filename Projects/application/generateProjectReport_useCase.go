@@ -0,0 +1,101 @@
+package application
+
+import (
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+const (
+	// maxReportMeasurements es el máximo de puntos que se listan en el reporte
+	maxReportMeasurements = 1000
+	// maxReportImages es el máximo de imágenes de la galería que se incluyen en el reporte
+	maxReportImages = 12
+	// reportImageDownloads es cuántas imágenes se descargan a la vez
+	reportImageDownloads = 4
+)
+
+type GenerateProjectReportUseCase struct {
+	db           repository.ProjectRepository
+	media        repository.ProjectMediaRepository
+	measurements repository.MeasurementRepository
+	tags         repository.TagRepository
+	images       services.IImageLoader
+}
+
+func NewGenerateProjectReportUseCase(db repository.ProjectRepository, media repository.ProjectMediaRepository, measurements repository.MeasurementRepository, tags repository.TagRepository, images services.IImageLoader) *GenerateProjectReportUseCase {
+	return &GenerateProjectReportUseCase{db: db, media: media, measurements: measurements, tags: tags, images: images}
+}
+
+// Execute reúne los datos del proyecto y escribe su reporte PDF en w, con los textos en el idioma
+// lang y las fechas en la zona horaria loc. Las imágenes que no se pueden descargar aparecen como
+// "no disponible" sin impedir el reporte
+func (uc *GenerateProjectReportUseCase) Execute(projectId int, w io.Writer, lang string, loc *time.Location) error {
+	lang, err := services.ParseExportLanguage(lang)
+	if err != nil {
+		return err
+	}
+	project, err := uc.db.FindById(projectId)
+	if err != nil {
+		return err
+	}
+
+	report := entities.ProjectReport{
+		Project:     *project,
+		Language:    lang,
+		Location:    loc,
+		GeneratedAt: time.Now().UTC(),
+	}
+	if report.Tags, err = uc.tags.FindByProject(projectId); err != nil {
+		return err
+	}
+	// Se pide un punto extra para saber si el proyecto tiene más de los que se listan
+	if report.Measurements, err = uc.measurements.FindByProject(projectId, 0, maxReportMeasurements+1); err != nil {
+		return err
+	}
+	if len(report.Measurements) > maxReportMeasurements {
+		report.Measurements = report.Measurements[:maxReportMeasurements]
+		report.MoreMeasurements = true
+	}
+
+	media, err := uc.media.FindByProject(projectId)
+	if err != nil {
+		return err
+	}
+	report.Images = uc.loadImages(media[:min(len(media), maxReportImages)])
+
+	return services.RenderProjectReport(w, report)
+}
+
+// loadImages descarga las imágenes de la galería en paralelo, conservando su orden
+func (uc *GenerateProjectReportUseCase) loadImages(media []entities.ProjectMedia) []entities.ReportImage {
+	images := make([]entities.ReportImage, len(media))
+	slots := make(chan struct{}, reportImageDownloads)
+	var wg sync.WaitGroup
+	for i, item := range media {
+		images[i].Media = item
+		if uc.images == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, url string) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			data, err := uc.images.Load(url)
+			if err != nil {
+				log.Printf("WARNING: No se pudo descargar la imagen %s para el reporte: %v", url, err)
+				return
+			}
+			images[i].Data = data
+		}(i, item.Url)
+	}
+	wg.Wait()
+	return images
+}
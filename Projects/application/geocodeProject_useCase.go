@@ -0,0 +1,50 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type GeocodeProjectUseCase struct {
+	db       repository.ProjectRepository
+	geocoder services.IGeocoder
+}
+
+// NewGeocodeProjectUseCase recibe geocoder nil cuando no hay geocodificador configurado
+func NewGeocodeProjectUseCase(db repository.ProjectRepository, geocoder services.IGeocoder) *GeocodeProjectUseCase {
+	return &GeocodeProjectUseCase{db: db, geocoder: geocoder}
+}
+
+// Execute geocodifica el proyecto en el momento, sin pasar por la cola, y devuelve el proyecto con la
+// dirección actualizada. Sirve para proyectos anteriores al geocodificador o cuya geocodificación falló
+func (uc *GeocodeProjectUseCase) Execute(id int) (*entities.Project, error) {
+	if uc.geocoder == nil {
+		return nil, fmt.Errorf("%w: no hay un geocodificador configurado", entities.ErrUnavailable)
+	}
+	project, err := uc.db.FindById(id)
+	if err != nil {
+		return nil, err
+	}
+	if project.Lat == 0 && project.Lng == 0 {
+		return nil, fmt.Errorf("%w: el proyecto no tiene ubicación", entities.ErrInvalidInput)
+	}
+
+	address, err := uc.geocoder.ReverseGeocode(project.Lat, project.Lng)
+	if errors.Is(err, entities.ErrNotFound) {
+		address, err = &entities.ProjectAddress{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entities.ErrUnavailable, err)
+	}
+	address.GeocodedAt = time.Now().UTC()
+
+	if err := uc.db.UpdateAddress(id, *address); err != nil {
+		return nil, err
+	}
+	return uc.db.FindById(id)
+}
@@ -2,8 +2,18 @@ package application
 
 
 import (
+	"fmt"
+	"math"
+	"strings"
+
 	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
 	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
 )
 
 type GetAllProjectsUseCase struct {
@@ -14,10 +24,90 @@ func NewGeProjectsUseCase(db repository.ProjectRepository) *GetAllProjectsUseCas
 	return &GetAllProjectsUseCase{db: db}
 }
 
-func (gp *GetAllProjectsUseCase) Execute() ([]entities.Project, error) {
-	project, err := gp.db.FindAll()
+func (gp *GetAllProjectsUseCase) Execute(filter entities.ProjectFilter) (*entities.ProjectPage, error) {
+	if err := normalizeProjectFilter(&filter); err != nil {
+		return nil, err
+	}
+	page, err := gp.db.FindFiltered(filter)
 	if err != nil {
 		return nil, err
 	}
-	return project, nil
-}
\ No newline at end of file
+	return page, nil
+}
+
+// normalizeProjectFilter aplica valores por defecto y valida los filtros de un listado
+func normalizeProjectFilter(filter *entities.ProjectFilter) error {
+	filter.Limit = clampLimit(filter.Limit)
+
+	if filter.SortField == "" {
+		filter.SortField = entities.SortById
+		filter.SortDesc = true
+	}
+	switch filter.SortField {
+	case entities.SortById, entities.SortByNombre, entities.SortByFecha, entities.SortByCategoria:
+	default:
+		return fmt.Errorf("%w: no se puede ordenar por %q", entities.ErrInvalidFilter, filter.SortField)
+	}
+
+	if !filter.FechaDesde.IsZero() && !filter.FechaHasta.IsZero() && !filter.FechaDesde.Before(filter.FechaHasta) {
+		return fmt.Errorf("%w: la fecha inicial es posterior a la final", entities.ErrInvalidFilter)
+	}
+
+	if err := normalizeTagFilter(filter); err != nil {
+		return err
+	}
+
+	for i, status := range filter.Statuses {
+		filter.Statuses[i] = strings.ToLower(strings.TrimSpace(status))
+		if !services.IsProjectStatus(filter.Statuses[i]) {
+			return fmt.Errorf("%w: estado %q desconocido", entities.ErrInvalidFilter, status)
+		}
+	}
+
+	if filter.BBox != nil {
+		if err := validateBoundingBox(*filter.BBox); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// normalizeTagFilter convierte las etiquetas del filtro a slugs y valida el modo de combinación
+func normalizeTagFilter(filter *entities.ProjectFilter) error {
+	if filter.TagMatch == "" {
+		filter.TagMatch = entities.TagMatchAll
+	}
+	if filter.TagMatch != entities.TagMatchAll && filter.TagMatch != entities.TagMatchAny {
+		return fmt.Errorf("%w: tagMatch debe ser %q o %q", entities.ErrInvalidFilter, entities.TagMatchAll, entities.TagMatchAny)
+	}
+	if len(filter.Tags) == 0 {
+		return nil
+	}
+
+	tags, err := services.NormalizeTags(filter.Tags)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entities.ErrInvalidFilter, err.Error())
+	}
+	filter.Tags = make([]string, 0, len(tags))
+	for _, tag := range tags {
+		filter.Tags = append(filter.Tags, tag.Slug)
+	}
+	return nil
+}
+
+// validateBoundingBox comprueba los rangos de un bbox; MinLng puede ser mayor que MaxLng si cruza el antimeridiano
+func validateBoundingBox(bbox entities.BoundingBox) error {
+	for _, v := range []float64{bbox.MinLng, bbox.MinLat, bbox.MaxLng, bbox.MaxLat} {
+		if math.IsNaN(v) {
+			return fmt.Errorf("%w: bbox contiene un valor no numérico", entities.ErrInvalidFilter)
+		}
+	}
+	if bbox.MinLat < -90 || bbox.MaxLat > 90 || bbox.MinLat > bbox.MaxLat {
+		return fmt.Errorf("%w: latitudes del bbox fuera de rango", entities.ErrInvalidFilter)
+	}
+	if bbox.MinLng < -180 || bbox.MinLng > 180 || bbox.MaxLng < -180 || bbox.MaxLng > 180 {
+		return fmt.Errorf("%w: longitudes del bbox fuera de rango", entities.ErrInvalidFilter)
+	}
+	return nil
+}
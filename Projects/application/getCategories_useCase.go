@@ -0,0 +1,32 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type GetCategoriesUseCase struct {
+	categories repository.CategoryRepository
+}
+
+func NewGetCategoriesUseCase(categories repository.CategoryRepository) *GetCategoriesUseCase {
+	return &GetCategoriesUseCase{categories: categories}
+}
+
+// Execute devuelve el catálogo; con orgId mayor a 0 aplica las personalizaciones de esa organización
+func (uc *GetCategoriesUseCase) Execute(orgId int) ([]entities.Category, error) {
+	categories, err := uc.categories.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	if orgId <= 0 {
+		return categories, nil
+	}
+
+	overrides, err := uc.categories.FindOverrides(orgId)
+	if err != nil {
+		return nil, err
+	}
+	return services.ApplyCategoryOverrides(categories, overrides), nil
+}
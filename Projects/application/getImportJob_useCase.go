@@ -0,0 +1,23 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type GetImportJobUseCase struct {
+	jobs *services.ImportJobService
+}
+
+func NewGetImportJobUseCase(jobs *services.ImportJobService) *GetImportJobUseCase {
+	return &GetImportJobUseCase{jobs: jobs}
+}
+
+// Execute devuelve el avance de una importación en segundo plano y, al terminar, su reporte
+func (uc *GetImportJobUseCase) Execute(id string) (*entities.ImportJob, error) {
+	job, err := uc.jobs.GetImportJob(id)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
@@ -0,0 +1,44 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+const (
+	defaultMeasurementPage = 500
+	maxMeasurementPage     = 5000
+)
+
+type GetMeasurementsUseCase struct {
+	db           repository.ProjectRepository
+	measurements repository.MeasurementRepository
+}
+
+func NewGetMeasurementsUseCase(db repository.ProjectRepository, measurements repository.MeasurementRepository) *GetMeasurementsUseCase {
+	return &GetMeasurementsUseCase{db: db, measurements: measurements}
+}
+
+// Execute lista los puntos del proyecto en orden de inserción, paginados por Id
+func (uc *GetMeasurementsUseCase) Execute(projectId, afterId, limit int) (*entities.MeasurementPage, error) {
+	if limit <= 0 {
+		limit = defaultMeasurementPage
+	}
+	limit = min(limit, maxMeasurementPage)
+	if _, err := uc.db.FindById(projectId); err != nil {
+		return nil, err
+	}
+
+	// Se pide un punto extra para saber si hay una página siguiente
+	measurements, err := uc.measurements.FindByProject(projectId, max(afterId, 0), limit+1)
+	if err != nil {
+		return nil, err
+	}
+	page := &entities.MeasurementPage{Limit: limit}
+	if len(measurements) > limit {
+		measurements = measurements[:limit]
+		page.NextAfter = measurements[limit-1].Id
+	}
+	page.Measurements = measurements
+	return page, nil
+}
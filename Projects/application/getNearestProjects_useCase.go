@@ -0,0 +1,30 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type GetNearestProjectsUseCase struct {
+	db repository.ProjectRepository
+}
+
+func NewGetNearestProjectsUseCase(db repository.ProjectRepository) *GetNearestProjectsUseCase {
+	return &GetNearestProjectsUseCase{db: db}
+}
+
+func (uc *GetNearestProjectsUseCase) Execute(lat, lng float64, k int) ([]entities.ProjectDistance, error) {
+	if err := services.ValidateCoordinates(lat, lng); err != nil {
+		return nil, fmt.Errorf("%w: %v", entities.ErrInvalidFilter, err)
+	}
+	k = clampLimit(k)
+
+	projects, err := uc.db.FindNearest(lat, lng, k)
+	if err != nil {
+		return nil, err
+	}
+	return withDistances(projects, lat, lng), nil
+}
@@ -3,6 +3,7 @@ package application
 import (
 	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
 	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
 )
 
 type GetProjectsByCategoryUseCase struct {
@@ -13,6 +14,7 @@ func NewGetProjectsByCategoryUseCase(repo repository.ProjectRepository) *GetProj
 	return &GetProjectsByCategoryUseCase{projectRepo: repo}
 }
 
+// Execute busca por el slug de la categoría; también acepta el nombre, que se convierte a slug
 func (uc *GetProjectsByCategoryUseCase) Execute(categoria string) ([]entities.Project, error) {
-	return uc.projectRepo.FindByCategory(categoria)
+	return uc.projectRepo.FindByCategory(services.Slugify(categoria))
 }
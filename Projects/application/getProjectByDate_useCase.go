@@ -1,10 +1,16 @@
 package application
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
 	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
 )
 
+// maxProjectsByDate limita el listado de un día, que no está paginado
+const maxProjectsByDate = 1000
+
 type GetProjectsByDateUseCase struct {
 	projectRepo repository.ProjectRepository
 }
@@ -13,6 +19,15 @@ func NewGetProjectsByDateUseCase(repo repository.ProjectRepository) *GetProjects
 	return &GetProjectsByDateUseCase{projectRepo: repo}
 }
 
-func (uc *GetProjectsByDateUseCase) Execute(fecha string) ([]entities.Project, error) {
-	return uc.projectRepo.FindByDate(fecha)
+// Execute devuelve los proyectos cuya fecha cae dentro del día indicado (AAAA-MM-DD) en la zona horaria
+// loc, hasta maxProjectsByDate
+func (uc *GetProjectsByDateUseCase) Execute(fecha string, loc *time.Location) ([]entities.Project, error) {
+	day, err := time.ParseInLocation(entities.DateLayout, fecha, loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: la fecha %q debe tener formato AAAA-MM-DD", entities.ErrInvalidFilter, fecha)
+	}
+	return uc.projectRepo.FindByDateRange(entities.ProjectDateQuery{
+		Range: entities.DateRange{From: day.UTC(), To: day.AddDate(0, 0, 1).UTC()},
+		Limit: maxProjectsByDate,
+	})
 }
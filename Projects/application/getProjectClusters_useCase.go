@@ -0,0 +1,66 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// maxMapPoints limita los proyectos individuales que se devuelven con zoom alto
+const maxMapPoints = 5000
+
+type GetProjectClustersUseCase struct {
+	db repository.ProjectRepository
+}
+
+func NewGetProjectClustersUseCase(db repository.ProjectRepository) *GetProjectClustersUseCase {
+	return &GetProjectClustersUseCase{db: db}
+}
+
+// Execute agrupa los proyectos del bbox del filtro en celdas según el zoom; desde
+// services.MapPointsMinZoom devuelve los proyectos individuales
+func (uc *GetProjectClustersUseCase) Execute(filter entities.ProjectFilter, zoom int) (*entities.ProjectMap, error) {
+	if filter.BBox == nil {
+		return nil, fmt.Errorf("%w: el parámetro bbox es obligatorio", entities.ErrInvalidFilter)
+	}
+	if zoom < 0 || zoom > services.MaxMapZoom {
+		return nil, fmt.Errorf("%w: zoom debe estar entre 0 y %d", entities.ErrInvalidFilter, services.MaxMapZoom)
+	}
+	if err := normalizeProjectFilter(&filter); err != nil {
+		return nil, err
+	}
+
+	result := &entities.ProjectMap{Mode: entities.MapModeClusters, Zoom: zoom}
+	if zoom >= services.MapPointsMinZoom {
+		points, err := uc.db.FindMapPoints(filter, maxMapPoints+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(points) > maxMapPoints {
+			points = points[:maxMapPoints]
+			result.Truncated = true
+		}
+		result.Mode = entities.MapModePoints
+		result.Points = points
+		result.Total = len(points)
+		result.MaxCount = min(len(points), 1)
+		return result, nil
+	}
+
+	gridSize := services.MapGridSize(zoom)
+	if err := services.ValidateMapArea(*filter.BBox, gridSize); err != nil {
+		return nil, err
+	}
+	clusters, err := uc.db.ClusterWithin(entities.ProjectMapQuery{Filter: filter, Zoom: zoom, GridSize: gridSize})
+	if err != nil {
+		return nil, err
+	}
+	for _, cluster := range clusters {
+		result.Total += cluster.Count
+		result.MaxCount = max(result.MaxCount, cluster.Count)
+	}
+	result.Clusters = clusters
+	return result, nil
+}
@@ -0,0 +1,22 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type GetProjectDocumentsUseCase struct {
+	db        repository.ProjectRepository
+	documents repository.ProjectDocumentRepository
+}
+
+func NewGetProjectDocumentsUseCase(db repository.ProjectRepository, documents repository.ProjectDocumentRepository) *GetProjectDocumentsUseCase {
+	return &GetProjectDocumentsUseCase{db: db, documents: documents}
+}
+
+func (uc *GetProjectDocumentsUseCase) Execute(projectId int) ([]entities.ProjectDocument, error) {
+	if _, err := uc.db.FindById(projectId); err != nil {
+		return nil, err
+	}
+	return uc.documents.FindByProject(projectId)
+}
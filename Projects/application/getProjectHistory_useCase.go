@@ -0,0 +1,43 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type GetProjectHistoryUseCase struct {
+	db        repository.ProjectRepository
+	revisions repository.ProjectRevisionRepository
+}
+
+func NewGetProjectHistoryUseCase(db repository.ProjectRepository, revisions repository.ProjectRevisionRepository) *GetProjectHistoryUseCase {
+	return &GetProjectHistoryUseCase{db: db, revisions: revisions}
+}
+
+// Execute devuelve una página de las revisiones del proyecto anteriores a before (0 empieza por la más
+// reciente), de la más reciente a la más antigua. El historial de un proyecto eliminado se sigue pudiendo consultar
+func (uc *GetProjectHistoryUseCase) Execute(projectId, before, limit int) (*entities.ProjectHistoryPage, error) {
+	if before < 0 {
+		return nil, fmt.Errorf("%w: before no puede ser negativo", entities.ErrInvalidFilter)
+	}
+
+	// Se pide una revisión de más para saber si existe una página siguiente
+	page := &entities.ProjectHistoryPage{Limit: clampLimit(limit)}
+	revisions, err := uc.revisions.FindRevisions(projectId, before, page.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 && before == 0 {
+		if _, err := uc.db.FindById(projectId); err != nil {
+			return nil, err
+		}
+	}
+	if len(revisions) > page.Limit {
+		revisions = revisions[:page.Limit]
+		page.NextBefore = revisions[len(revisions)-1].Revision
+	}
+	page.Revisions = revisions
+	return page, nil
+}
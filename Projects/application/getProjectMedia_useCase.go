@@ -0,0 +1,22 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type GetProjectMediaUseCase struct {
+	db    repository.ProjectRepository
+	media repository.ProjectMediaRepository
+}
+
+func NewGetProjectMediaUseCase(db repository.ProjectRepository, media repository.ProjectMediaRepository) *GetProjectMediaUseCase {
+	return &GetProjectMediaUseCase{db: db, media: media}
+}
+
+func (uc *GetProjectMediaUseCase) Execute(projectId int) ([]entities.ProjectMedia, error) {
+	if _, err := uc.db.FindById(projectId); err != nil {
+		return nil, err
+	}
+	return uc.media.FindByProject(projectId)
+}
@@ -3,9 +3,11 @@ package application
 import (
     "fmt"
     "log"
+    "time"
 
     "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
     "github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+    "github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
 )
 
 type GetProjectStatsUseCase struct {
@@ -18,35 +20,49 @@ func NewGetProjectStatsUseCase(db repository.ProjectRepository) *GetProjectStats
     }
 }
 
-func (uc *GetProjectStatsUseCase) Execute(userId int, days int) (*entities.ProjectStats, error) {
-    if userId <= 0 {
-        return nil, fmt.Errorf("userId debe ser mayor a 0")
+// Execute calcula las estadísticas de los proyectos de userId, o de toda la organización si es 0,
+// agrupadas por día, semana o mes en la zona horaria loc. El rango se ajusta a periodos completos y
+// se compara con el rango anterior del mismo número de periodos
+func (uc *GetProjectStatsUseCase) Execute(userId int, granularity string, dateRange entities.DateRange, loc *time.Location) (*entities.ProjectStats, error) {
+    if userId < 0 {
+        return nil, fmt.Errorf("%w: userId no puede ser negativo", entities.ErrInvalidFilter)
     }
 
-    if days <= 0 {
-        days = 7 
+    if loc == nil {
+        loc = time.UTC
     }
 
-    log.Printf("INFO: Obteniendo estadísticas de proyectos - UserId: %d, Días: %d", userId, days)
-
-    dailyCounts, err := uc.db.GetProjectsStats(userId, days)
+    granularity, err := services.ParseStatsGranularity(granularity)
     if err != nil {
-        log.Printf("ERROR: Error al obtener estadísticas: %v", err)
         return nil, err
     }
-
-    totalCount := 0
-    for _, dc := range dailyCounts {
-        totalCount += dc.Count
+    aligned, err := services.AlignStatsRange(dateRange, granularity, time.Now(), loc)
+    if err != nil {
+        return nil, err
     }
 
-    stats := &entities.ProjectStats{
-        UserId:     userId,
-        TotalCount: totalCount,
-        Daily:      dailyCounts,
+    log.Printf("INFO: Obteniendo estadísticas de proyectos - UserId: %d, Granularidad: %s, Zona: %s", userId, granularity, loc)
+
+    aggregator := services.NewProjectStatsAggregator(entities.ProjectStatsQuery{
+        UserId:      userId,
+        Granularity: granularity,
+        Range:       aligned,
+        Location:    loc,
+    })
+    // Una sola consulta cubre el rango anterior y el pedido
+    queryRange := entities.DateRange{From: aggregator.PreviousRange().From, To: aligned.To}
+    err = uc.db.StreamStatsRecords(userId, queryRange, func(record entities.ProjectStatsRecord) error {
+        aggregator.Add(record)
+        return nil
+    })
+    if err != nil {
+        log.Printf("ERROR: Error al obtener estadísticas: %v", err)
+        return nil, err
     }
 
-    log.Printf("SUCCESS: Estadísticas obtenidas - Total: %d proyectos en %d días", totalCount, len(dailyCounts))
+    stats := aggregator.Result()
+
+    log.Printf("SUCCESS: Estadísticas obtenidas - Total: %d proyectos en %d periodos", stats.TotalCount, len(stats.Series))
 
     return stats, nil
-}
\ No newline at end of file
+}
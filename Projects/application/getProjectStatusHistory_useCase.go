@@ -0,0 +1,23 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type GetProjectStatusHistoryUseCase struct {
+	db       repository.ProjectRepository
+	statuses repository.ProjectStatusRepository
+}
+
+func NewGetProjectStatusHistoryUseCase(db repository.ProjectRepository, statuses repository.ProjectStatusRepository) *GetProjectStatusHistoryUseCase {
+	return &GetProjectStatusHistoryUseCase{db: db, statuses: statuses}
+}
+
+// Execute devuelve las transiciones de estado del proyecto de la más reciente a la más antigua
+func (uc *GetProjectStatusHistoryUseCase) Execute(projectId int) ([]entities.ProjectStatusTransition, error) {
+	if _, err := uc.db.FindById(projectId); err != nil {
+		return nil, err
+	}
+	return uc.statuses.FindTransitions(projectId)
+}
@@ -0,0 +1,17 @@
+package application
+
+import "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+
+type GetProjectStatusWorkflowUseCase struct {
+	workflow entities.ProjectStatusWorkflow
+}
+
+func NewGetProjectStatusWorkflowUseCase(workflow entities.ProjectStatusWorkflow) *GetProjectStatusWorkflowUseCase {
+	return &GetProjectStatusWorkflowUseCase{workflow: workflow}
+}
+
+// Execute devuelve los estados y las transiciones permitidas, para que los clientes muestren solo
+// las acciones válidas
+func (uc *GetProjectStatusWorkflowUseCase) Execute() entities.ProjectStatusWorkflow {
+	return uc.workflow
+}
@@ -0,0 +1,22 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type GetProjectTagsUseCase struct {
+	db   repository.ProjectRepository
+	tags repository.TagRepository
+}
+
+func NewGetProjectTagsUseCase(db repository.ProjectRepository, tags repository.TagRepository) *GetProjectTagsUseCase {
+	return &GetProjectTagsUseCase{db: db, tags: tags}
+}
+
+func (uc *GetProjectTagsUseCase) Execute(projectId int) ([]entities.Tag, error) {
+	if _, err := uc.db.FindById(projectId); err != nil {
+		return nil, err
+	}
+	return uc.tags.FindByProject(projectId)
+}
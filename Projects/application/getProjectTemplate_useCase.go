@@ -0,0 +1,18 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type GetProjectTemplateUseCase struct {
+	templates repository.ProjectTemplateRepository
+}
+
+func NewGetProjectTemplateUseCase(templates repository.ProjectTemplateRepository) *GetProjectTemplateUseCase {
+	return &GetProjectTemplateUseCase{templates: templates}
+}
+
+func (uc *GetProjectTemplateUseCase) Execute(id int) (*entities.ProjectTemplate, error) {
+	return uc.templates.FindById(id)
+}
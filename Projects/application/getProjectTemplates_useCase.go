@@ -0,0 +1,19 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type GetProjectTemplatesUseCase struct {
+	templates repository.ProjectTemplateRepository
+}
+
+func NewGetProjectTemplatesUseCase(templates repository.ProjectTemplateRepository) *GetProjectTemplatesUseCase {
+	return &GetProjectTemplatesUseCase{templates: templates}
+}
+
+// Execute devuelve todas las plantillas ordenadas por nombre
+func (uc *GetProjectTemplatesUseCase) Execute() ([]entities.ProjectTemplate, error) {
+	return uc.templates.FindAll()
+}
@@ -0,0 +1,56 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// maxSearchRadiusMeters limita el radio de las búsquedas por cercanía
+const maxSearchRadiusMeters = 1000000
+
+type GetProjectsNearUseCase struct {
+	db repository.ProjectRepository
+}
+
+func NewGetProjectsNearUseCase(db repository.ProjectRepository) *GetProjectsNearUseCase {
+	return &GetProjectsNearUseCase{db: db}
+}
+
+func (uc *GetProjectsNearUseCase) Execute(lat, lng, radiusMeters float64, limit int) ([]entities.ProjectDistance, error) {
+	if err := services.ValidateCoordinates(lat, lng); err != nil {
+		return nil, fmt.Errorf("%w: %v", entities.ErrInvalidFilter, err)
+	}
+	if radiusMeters <= 0 || radiusMeters > maxSearchRadiusMeters {
+		return nil, fmt.Errorf("%w: el radio debe estar entre 0 y %d metros", entities.ErrInvalidFilter, maxSearchRadiusMeters)
+	}
+	limit = clampLimit(limit)
+
+	projects, err := uc.db.FindNear(lat, lng, radiusMeters, limit)
+	if err != nil {
+		return nil, err
+	}
+	return withDistances(projects, lat, lng), nil
+}
+
+// clampLimit aplica el tamaño de página por defecto y el máximo permitido
+func clampLimit(limit int) int {
+	if limit <= 0 {
+		return defaultPageLimit
+	}
+	return min(limit, maxPageLimit)
+}
+
+// withDistances agrega a cada proyecto su distancia haversine al punto de referencia
+func withDistances(projects []entities.Project, lat, lng float64) []entities.ProjectDistance {
+	results := make([]entities.ProjectDistance, 0, len(projects))
+	for _, project := range projects {
+		results = append(results, entities.ProjectDistance{
+			Project:        project,
+			DistanceMeters: services.HaversineDistance(lat, lng, project.Lat, project.Lng),
+		})
+	}
+	return results
+}
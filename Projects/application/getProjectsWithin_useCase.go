@@ -0,0 +1,21 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type GetProjectsWithinUseCase struct {
+	db repository.ProjectRepository
+}
+
+func NewGetProjectsWithinUseCase(db repository.ProjectRepository) *GetProjectsWithinUseCase {
+	return &GetProjectsWithinUseCase{db: db}
+}
+
+func (uc *GetProjectsWithinUseCase) Execute(bbox entities.BoundingBox, limit int) ([]entities.Project, error) {
+	if err := validateBoundingBox(bbox); err != nil {
+		return nil, err
+	}
+	return uc.db.FindWithin(bbox, clampLimit(limit))
+}
@@ -0,0 +1,191 @@
+package application
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+const (
+	// maxCSVImportRows limita las filas de un CSV
+	maxCSVImportRows = 50000
+	// csvImportSyncRows es el máximo de filas que se procesan durante la petición; los archivos más
+	// grandes se importan en segundo plano
+	csvImportSyncRows = 500
+	// csvImportProgressStep es cada cuántas filas se actualiza el avance de un trabajo
+	csvImportProgressStep = 100
+)
+
+type ImportProjectsCSVUseCase struct {
+	db         repository.ProjectRepository
+	categories repository.CategoryRepository
+	jobs       *services.ImportJobService
+	geocodeSrv *services.GeocodingWorkerService
+}
+
+func NewImportProjectsCSVUseCase(db repository.ProjectRepository, categories repository.CategoryRepository, jobs *services.ImportJobService, geocodeSrv *services.GeocodingWorkerService) *ImportProjectsCSVUseCase {
+	return &ImportProjectsCSVUseCase{db: db, categories: categories, jobs: jobs, geocodeSrv: geocodeSrv}
+}
+
+// Execute lee el CSV y crea un proyecto por fila válida, reportando el resultado de cada una (index es
+// el número de línea del archivo). Con DryRun solo valida. Si el archivo supera csvImportSyncRows filas
+// o se pide Async, la importación continúa en segundo plano y se devuelve el trabajo en lugar del reporte.
+// Los proyectos pertenecen a opts.UserId; solo un administrador puede asignar otro dueño con user_id
+func (uc *ImportProjectsCSVUseCase) Execute(data []byte, opts entities.CSVImportOptions) (*entities.ImportReport, *entities.ImportJob, error) {
+	if opts.UserId <= 0 {
+		return nil, nil, fmt.Errorf("%w: se requiere un usuario autenticado", entities.ErrInvalidInput)
+	}
+	header, records, err := readCSVRecords(data, opts.Delimiter)
+	if err != nil {
+		return nil, nil, err
+	}
+	columns, err := services.ResolveCSVColumns(header, opts.Mapping)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts.Async || len(records) > csvImportSyncRows {
+		job, err := uc.jobs.SubmitImportJob(len(records), opts.DryRun, func(progress func(int)) (*entities.ImportReport, error) {
+			return uc.importRecords(records, columns, opts, progress), nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, &job, nil
+	}
+	return uc.importRecords(records, columns, opts, nil), nil, nil
+}
+
+// readCSVRecords separa el encabezado de las filas. Quita el BOM que agrega Excel y, sin delimiter,
+// detecta el separador en el encabezado
+func readCSVRecords(data []byte, delimiter rune) ([]string, []csvRecord, error) {
+	data = bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))
+	if delimiter == 0 {
+		firstLine, _, _ := bufio.NewReader(bytes.NewReader(data)).ReadLine()
+		delimiter = services.DetectCSVDelimiter(string(firstLine))
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("%w: el CSV está vacío", entities.ErrInvalidInput)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: CSV inválido: %v", entities.ErrInvalidInput, err)
+	}
+
+	records := make([]csvRecord, 0)
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: CSV inválido: %v", entities.ErrInvalidInput, err)
+		}
+		if strings.TrimSpace(strings.Join(fields, "")) == "" {
+			continue
+		}
+		if len(records) == maxCSVImportRows {
+			return nil, nil, fmt.Errorf("%w: se permiten como máximo %d filas por importación", entities.ErrInvalidInput, maxCSVImportRows)
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, csvRecord{line: line, fields: fields})
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("%w: el CSV no contiene filas", entities.ErrInvalidInput)
+	}
+	return header, records, nil
+}
+
+// csvRecord es una fila del CSV con su número de línea en el archivo
+type csvRecord struct {
+	line   int
+	fields []string
+}
+
+// importRecords valida y, salvo en DryRun, guarda cada fila. Una fila inválida no detiene las demás
+func (uc *ImportProjectsCSVUseCase) importRecords(records []csvRecord, columns services.CSVColumns, opts entities.CSVImportOptions, progress func(int)) *entities.ImportReport {
+	report := &entities.ImportReport{
+		Total:   len(records),
+		DryRun:  opts.DryRun,
+		Results: make([]entities.ImportItemResult, 0, len(records)),
+	}
+	// Las filas suelen repetir pocas categorías; se consultan una vez por importación
+	resolved := make(map[string]entities.Project)
+	failedCategories := make(map[string]error)
+
+	for i, record := range records {
+		result := entities.ImportItemResult{Index: record.line}
+
+		project, err := services.CSVRecordToProject(record.fields, columns, opts.UserId, opts.Location)
+		if err == nil && !services.CanModifyProject(project, opts.UserId, opts.IsAdmin) {
+			err = fmt.Errorf("solo un administrador puede importar proyectos de otro usuario")
+		}
+		if err == nil {
+			project.Status = entities.StatusDraft
+			err = uc.resolveCategory(&project, resolved, failedCategories)
+		}
+		if err == nil {
+			err = validateProjectFields(project)
+		}
+		if err == nil && !opts.DryRun {
+			result.ProjectId, err = uc.db.Save(project, entities.ProjectRevision{Action: entities.RevisionActionCreate, EditorId: project.UserId})
+		}
+
+		switch {
+		case err != nil:
+			result.Error = err.Error()
+			report.Failed++
+		case opts.DryRun:
+			result.Success = true
+			report.Valid++
+		default:
+			result.Success = true
+			report.Created++
+			project.Id = result.ProjectId
+			requestGeocoding(uc.geocodeSrv, nil, project)
+		}
+		report.Results = append(report.Results, result)
+
+		if progress != nil && (i+1)%csvImportProgressStep == 0 {
+			progress(i + 1)
+		}
+	}
+
+	log.Printf("INFO: Importación CSV - Total: %d, Creados: %d, Válidos: %d, Fallidos: %d", report.Total, report.Created, report.Valid, report.Failed)
+	return report
+}
+
+func (uc *ImportProjectsCSVUseCase) resolveCategory(project *entities.Project, resolved map[string]entities.Project, failed map[string]error) error {
+	key := services.Slugify(project.Categoria)
+	if err, ok := failed[key]; ok {
+		return err
+	}
+	if category, ok := resolved[key]; ok {
+		project.CategoryId, project.Categoria = category.CategoryId, category.Categoria
+		return nil
+	}
+
+	if err := resolveProjectCategory(uc.categories, project); err != nil {
+		if errors.Is(err, entities.ErrInvalidInput) {
+			failed[key] = err
+		}
+		return err
+	}
+	resolved[key] = *project
+	return nil
+}
@@ -0,0 +1,94 @@
+package application
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// maxImportFeatures limita la cantidad de Features por importación
+const maxImportFeatures = 1000
+
+type ImportProjectsGeoJSONUseCase struct {
+	db         repository.ProjectRepository
+	categories repository.CategoryRepository
+	geocodeSrv *services.GeocodingWorkerService
+}
+
+func NewImportProjectsGeoJSONUseCase(db repository.ProjectRepository, categories repository.CategoryRepository, geocodeSrv *services.GeocodingWorkerService) *ImportProjectsGeoJSONUseCase {
+	return &ImportProjectsGeoJSONUseCase{db: db, categories: categories, geocodeSrv: geocodeSrv}
+}
+
+// Execute crea un proyecto por cada Feature válido y reporta el resultado de cada uno.
+// Un Feature inválido no detiene la importación de los demás. Los proyectos pertenecen a userId, el
+// usuario autenticado; solo un administrador puede asignar otro dueño con la propiedad userId.
+// Las fechas sin zona horaria se interpretan en loc
+func (uc *ImportProjectsGeoJSONUseCase) Execute(collection entities.GeoJSONFeatureCollection, userId int, isAdmin bool, loc *time.Location) (*entities.ImportReport, error) {
+	if userId <= 0 {
+		return nil, fmt.Errorf("%w: se requiere un usuario autenticado", entities.ErrInvalidInput)
+	}
+	if collection.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("%w: se esperaba un FeatureCollection", entities.ErrInvalidInput)
+	}
+	if len(collection.Features) == 0 {
+		return nil, fmt.Errorf("%w: el FeatureCollection no contiene Features", entities.ErrInvalidInput)
+	}
+	if len(collection.Features) > maxImportFeatures {
+		return nil, fmt.Errorf("%w: se permiten como máximo %d Features por importación", entities.ErrInvalidInput, maxImportFeatures)
+	}
+
+	// Las coordenadas en otro CRS (miembro crs de GeoJSON 2008) se reproyectan a WGS84
+	crs, err := services.ParseGeoJSONCRS(collection.CRS)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &entities.ImportReport{
+		Total:   len(collection.Features),
+		Results: make([]entities.ImportItemResult, 0, len(collection.Features)),
+	}
+
+	for i, feature := range collection.Features {
+		result := entities.ImportItemResult{Index: i}
+
+		var project entities.Project
+		err := services.ReprojectGeoJSONGeometry(feature.Geometry, crs, entities.CRSWGS84)
+		if err == nil {
+			project, err = services.FeatureToProject(feature, userId, loc)
+		}
+		if err == nil && !services.CanModifyProject(project, userId, isAdmin) {
+			err = fmt.Errorf("solo un administrador puede importar proyectos de otro usuario")
+		}
+		if err == nil {
+			project.Status = entities.StatusDraft
+			err = resolveProjectCategory(uc.categories, &project)
+		}
+		if err == nil {
+			err = applyProjectGeometry(&project)
+		}
+		if err == nil {
+			err = validateProjectFields(project)
+		}
+		if err == nil {
+			result.ProjectId, err = uc.db.Save(project, entities.ProjectRevision{Action: entities.RevisionActionCreate, EditorId: project.UserId})
+		}
+
+		if err != nil {
+			result.Error = err.Error()
+			report.Failed++
+		} else {
+			result.Success = true
+			report.Created++
+			project.Id = result.ProjectId
+			requestGeocoding(uc.geocodeSrv, nil, project)
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	log.Printf("INFO: Importación GeoJSON - Total: %d, Creados: %d, Fallidos: %d", report.Total, report.Created, report.Failed)
+	return report, nil
+}
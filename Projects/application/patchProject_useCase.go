@@ -0,0 +1,111 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type PatchProjectUseCase struct {
+	db         repository.ProjectRepository
+	categories repository.CategoryRepository
+	geocodeSrv *services.GeocodingWorkerService
+}
+
+func NewPatchProjectUseCase(db repository.ProjectRepository, categories repository.CategoryRepository, geocodeSrv *services.GeocodingWorkerService) *PatchProjectUseCase {
+	return &PatchProjectUseCase{db: db, categories: categories, geocodeSrv: geocodeSrv}
+}
+
+// Execute aplica un JSON Merge Patch sobre la representación del proyecto. Solo se aceptan los campos
+// editables; Fecha admite los mismos formatos que al crear y se interpreta en loc si no trae zona horaria.
+// Geometry acepta GeoJSON o WKT y, mientras exista, su centroide reemplaza Lat/Lng.
+// expectedVersion 0 acepta cualquier versión. Solo el dueño o un administrador pueden modificar el proyecto
+func (uc *PatchProjectUseCase) Execute(id int, patch []byte, expectedVersion, editorId int, isAdmin bool, loc *time.Location) (*entities.Project, error) {
+	var changes map[string]interface{}
+	if err := json.Unmarshal(patch, &changes); err != nil || changes == nil {
+		return nil, fmt.Errorf("%w: el parche debe ser un objeto JSON", entities.ErrInvalidInput)
+	}
+	for field, value := range changes {
+		if !services.IsEditableProjectField(field) {
+			return nil, fmt.Errorf("%w: el campo %q no existe o no se puede modificar", entities.ErrInvalidInput, field)
+		}
+		if fecha, ok := value.(string); ok && field == "Fecha" {
+			parsed, err := entities.ParseProjectDate(fecha, loc)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", entities.ErrInvalidInput, err)
+			}
+			changes[field] = parsed.Format(time.RFC3339Nano)
+		}
+		// La geometría también se puede enviar como WKT
+		if wkt, ok := value.(string); ok && field == "Geometry" {
+			geometry, err := services.ParseGeometry(wkt)
+			if err != nil {
+				return nil, err
+			}
+			changes[field] = geometry
+		}
+	}
+
+	current, err := uc.db.FindById(id)
+	if err != nil {
+		return nil, err
+	}
+	if !services.CanModifyProject(*current, editorId, isAdmin) {
+		return nil, fmt.Errorf("%w: el proyecto pertenece a otro usuario", entities.ErrForbidden)
+	}
+	version, err := checkExpectedVersion(*current, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+	normalizedPatch, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := services.MergePatch(document, normalizedPatch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entities.ErrInvalidInput, err)
+	}
+
+	var project entities.Project
+	if err := json.Unmarshal(merged, &project); err != nil {
+		return nil, fmt.Errorf("%w: %v", entities.ErrInvalidInput, err)
+	}
+	project.Id = current.Id
+	project.Version = version
+	// Si solo cambia el nombre o slug de la categoría, el id anterior ya no aplica
+	if _, ok := changes["Categoria"]; ok {
+		if _, ok := changes["CategoryId"]; !ok {
+			project.CategoryId = 0
+		}
+	}
+	if err := resolveProjectCategory(uc.categories, &project); err != nil {
+		return nil, err
+	}
+	if err := applyProjectGeometry(&project); err != nil {
+		return nil, err
+	}
+	if err := validateProjectFields(project); err != nil {
+		return nil, err
+	}
+
+	change := entities.ProjectRevision{Action: entities.RevisionActionUpdate, EditorId: editorId}
+	if err := uc.db.Update(project, change); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.db.FindById(id)
+	if err != nil {
+		return nil, err
+	}
+	requestGeocoding(uc.geocodeSrv, current, *updated)
+	return updated, nil
+}
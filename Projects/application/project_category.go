@@ -0,0 +1,40 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// resolveProjectCategory asocia el proyecto a una categoría del catálogo, por CategoryId o por el slug
+// de Categoria, y copia en Categoria el nombre oficial. Las categorías fuera del catálogo se rechazan
+func resolveProjectCategory(categories repository.CategoryRepository, project *entities.Project) error {
+	var category *entities.Category
+	var err error
+	switch {
+	case project.CategoryId > 0:
+		category, err = categories.FindById(project.CategoryId)
+	case strings.TrimSpace(project.Categoria) != "":
+		category, err = categories.FindBySlug(services.Slugify(project.Categoria))
+	default:
+		return fmt.Errorf("%w: la categoría es obligatoria", entities.ErrInvalidInput)
+	}
+
+	if errors.Is(err, entities.ErrNotFound) {
+		if project.CategoryId > 0 {
+			return fmt.Errorf("%w: la categoría %d no existe en el catálogo", entities.ErrInvalidInput, project.CategoryId)
+		}
+		return fmt.Errorf("%w: la categoría %q no existe en el catálogo", entities.ErrInvalidInput, project.Categoria)
+	}
+	if err != nil {
+		return err
+	}
+
+	project.CategoryId = category.Id
+	project.Categoria = category.Nombre
+	return nil
+}
@@ -0,0 +1,34 @@
+package application
+
+import (
+	"log"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// projectDocumentLocations devuelve la ubicación de los archivos adjuntos de los proyectos. Se consulta
+// antes de eliminarlos porque el borrado en cascada quita los registros de project_documents
+func projectDocumentLocations(documents repository.ProjectDocumentRepository, projectIds ...int) ([]string, error) {
+	locations := make([]string, 0)
+	for _, projectId := range projectIds {
+		projectDocuments, err := documents.FindByProject(projectId)
+		if err != nil {
+			return nil, err
+		}
+		for _, document := range projectDocuments {
+			locations = append(locations, document.Location)
+		}
+	}
+	return locations, nil
+}
+
+// removeDocumentFiles elimina del almacenamiento los archivos de proyectos ya eliminados; si alguno no se
+// puede borrar solo se registra, porque el proyecto ya no existe
+func removeDocumentFiles(storage services.IDocumentStorage, locations []string) {
+	for _, location := range locations {
+		if err := storage.Delete(location); err != nil {
+			log.Printf("WARNING: No se pudo eliminar el archivo %s de un proyecto eliminado: %v", location, err)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package application
+
+import (
+	"log"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// requestGeocoding encola la geocodificación inversa del proyecto si es nuevo, si cambió de ubicación
+// respecto de previous o si todavía no tiene dirección. Sin geocodificador configurado (workerSrv nil)
+// o sin ubicación (0, 0) no hace nada; un fallo al encolar solo se registra
+func requestGeocoding(workerSrv *services.GeocodingWorkerService, previous *entities.Project, project entities.Project) {
+	if workerSrv == nil || (project.Lat == 0 && project.Lng == 0) {
+		return
+	}
+	if previous != nil && project.Address != nil && previous.Lat == project.Lat && previous.Lng == project.Lng {
+		return
+	}
+	if err := workerSrv.SubmitGeocodingJob(project.Id, project.Lat, project.Lng); err != nil {
+		log.Printf("WARNING: No se pudo encolar la geocodificación del proyecto %d: %v", project.Id, err)
+	}
+}
@@ -0,0 +1,24 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// applyProjectGeometry valida la geometría del proyecto y calcula en el servidor su área y perímetro.
+// El centroide reemplaza Lat/Lng para que las consultas por ubicación sigan funcionando con parcelas.
+// Sin geometría las medidas quedan en 0 y Lat/Lng no cambian
+func applyProjectGeometry(project *entities.Project) error {
+	if project.Geometry == nil {
+		project.AreaM2, project.PerimeterM = 0, 0
+		return nil
+	}
+	if err := services.ValidateGeometry(project.Geometry); err != nil {
+		return err
+	}
+	metrics := services.MeasureGeometry(*project.Geometry)
+	project.AreaM2 = metrics.AreaM2
+	project.PerimeterM = metrics.PerimeterM
+	project.Lat, project.Lng = metrics.CentroidLat, metrics.CentroidLng
+	return nil
+}
@@ -0,0 +1,69 @@
+package application
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// mediaUploadTimeout es el tiempo máximo de espera por cada imagen encolada en el worker
+const mediaUploadTimeout = 30 * time.Second
+
+// uploadProjectMedia sube las imágenes en paralelo a través del worker y devuelve las entradas de
+// la galería en el mismo orden recibido. Si alguna falla se devuelve el primer error y se eliminan las
+// que sí se subieron
+func uploadProjectMedia(workerSrv *services.ImageUploadWorkerService, uploads []entities.MediaUpload) ([]entities.ProjectMedia, error) {
+	media := make([]entities.ProjectMedia, len(uploads))
+	errs := make([]error, len(uploads))
+
+	var wg sync.WaitGroup
+	for i, upload := range uploads {
+		wg.Add(1)
+		go func(i int, upload entities.MediaUpload) {
+			defer wg.Done()
+			url, err := workerSrv.SubmitUploadJobSync(upload.LocalPath, mediaUploadTimeout)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			media[i] = entities.ProjectMedia{
+				Url:        url,
+				Caption:    upload.Caption,
+				CapturedAt: upload.CapturedAt,
+				Lat:        upload.Lat,
+				Lng:        upload.Lng,
+			}
+		}(i, upload)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			var uploaded []entities.ProjectMedia
+			for i := range media {
+				if errs[i] == nil {
+					uploaded = append(uploaded, media[i])
+				}
+			}
+			discardUploads(workerSrv, uploaded)
+			return nil, err
+		}
+	}
+	return media, nil
+}
+
+// discardUploads elimina imágenes ya subidas que no llegaron a guardarse en el proyecto. Un fallo solo
+// se registra: la petición ya está fallando por otro motivo y la imagen queda huérfana en el peor caso
+func discardUploads(workerSrv *services.ImageUploadWorkerService, media []entities.ProjectMedia) {
+	for _, item := range media {
+		if item.Url == "" {
+			continue
+		}
+		if err := workerSrv.DeleteUploadedImage(item.Url); err != nil {
+			log.Printf("WARNING: No se pudo eliminar la imagen huérfana %s: %v", item.Url, err)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// resolveTemplateCategory asocia la plantilla a una categoría del catálogo igual que a un proyecto
+func resolveTemplateCategory(categories repository.CategoryRepository, template *entities.ProjectTemplate) error {
+	project := entities.Project{CategoryId: template.CategoryId, Categoria: template.Categoria}
+	if err := resolveProjectCategory(categories, &project); err != nil {
+		return err
+	}
+	template.CategoryId, template.Categoria = project.CategoryId, project.Categoria
+	return nil
+}
+
+// applyProjectTemplate completa un proyecto nuevo con la plantilla indicada en TemplateId: la categoría
+// y la descripción solo si el proyecto no las trae y la lista de verificación con todas las tareas pendientes
+func applyProjectTemplate(templates repository.ProjectTemplateRepository, project *entities.Project) error {
+	if project.TemplateId == 0 {
+		return nil
+	}
+	template, err := templates.FindById(project.TemplateId)
+	if errors.Is(err, entities.ErrNotFound) {
+		return fmt.Errorf("%w: la plantilla %d no existe", entities.ErrInvalidInput, project.TemplateId)
+	}
+	if err != nil {
+		return err
+	}
+
+	if project.CategoryId == 0 && strings.TrimSpace(project.Categoria) == "" {
+		project.CategoryId = template.CategoryId
+	}
+	if strings.TrimSpace(project.Descripcion) == "" {
+		project.Descripcion = template.Descripcion
+	}
+	if len(project.Checklist) == 0 {
+		project.Checklist = services.ChecklistFromTemplate(template.Checklist)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// validateProjectFields verifica los campos obligatorios y las coordenadas de un proyecto
+func validateProjectFields(project entities.Project) error {
+	if project.NombreProyecto == "" {
+		return fmt.Errorf("%w: el nombre del proyecto es obligatorio", entities.ErrInvalidInput)
+	}
+	if project.Fecha.IsZero() {
+		return fmt.Errorf("%w: la fecha es obligatoria", entities.ErrInvalidInput)
+	}
+	if project.Categoria == "" {
+		return fmt.Errorf("%w: la categoría es obligatoria", entities.ErrInvalidInput)
+	}
+	if project.UserId <= 0 {
+		return fmt.Errorf("%w: el userId debe ser mayor a 0", entities.ErrInvalidInput)
+	}
+	if err := services.ValidateCoordinates(project.Lat, project.Lng); err != nil {
+		return fmt.Errorf("%w: %v", entities.ErrInvalidInput, err)
+	}
+	return services.ValidateChecklist(project.Checklist)
+}
+
+// checkExpectedVersion compara la versión que conoce el cliente con la actual y devuelve la versión
+// con la que se debe condicionar la escritura. 0 equivale a If-Match: * y acepta cualquier versión
+func checkExpectedVersion(current entities.Project, expected int) (int, error) {
+	if expected == 0 {
+		return current.Version, nil
+	}
+	if expected != current.Version {
+		return 0, fmt.Errorf("%w: el proyecto %d está en la versión %d, no en la %d", entities.ErrVersionConflict, current.Id, current.Version, expected)
+	}
+	return expected, nil
+}
@@ -1,2 +1,1510 @@
 // geova-back-1/Projects/application/projects_usecase_test.go
-package application
\ No newline at end of file
+package application
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// ============================================================================
+// Listado filtrado de proyectos
+// ============================================================================
+
+func TestNormalizeProjectFilter_Defaults(t *testing.T) {
+	filter := entities.ProjectFilter{}
+	if err := normalizeProjectFilter(&filter); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if filter.Limit != defaultPageLimit {
+		t.Errorf("limit esperado %d, obtenido %d", defaultPageLimit, filter.Limit)
+	}
+	if filter.SortField != entities.SortById || !filter.SortDesc {
+		t.Errorf("orden por defecto esperado -id, obtenido %q desc=%t", filter.SortField, filter.SortDesc)
+	}
+
+	filter = entities.ProjectFilter{Limit: 5000}
+	if err := normalizeProjectFilter(&filter); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if filter.Limit != maxPageLimit {
+		t.Errorf("limit esperado %d, obtenido %d", maxPageLimit, filter.Limit)
+	}
+}
+
+func TestNormalizeProjectFilter_Invalid(t *testing.T) {
+	cases := map[string]entities.ProjectFilter{
+		"orden desconocido": {SortField: "password"},
+		"rango invertido":   {FechaDesde: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), FechaHasta: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		"bbox fuera rango":  {BBox: &entities.BoundingBox{MinLng: -100, MinLat: -95, MaxLng: -90, MaxLat: 20}},
+		"bbox NaN":          {BBox: &entities.BoundingBox{MinLng: math.NaN(), MinLat: 19, MaxLng: -90, MaxLat: 20}},
+	}
+	for name, filter := range cases {
+		if err := normalizeProjectFilter(&filter); !errors.Is(err, entities.ErrInvalidFilter) {
+			t.Errorf("%s: se esperaba ErrInvalidFilter, obtenido %v", name, err)
+		}
+	}
+}
+
+// ============================================================================
+// Búsqueda de texto completo
+// ============================================================================
+
+// fakeSearchRepo devuelve resultados fijos y guarda la última consulta recibida
+type fakeSearchRepo struct {
+	results []entities.ProjectSearchResult
+	query   entities.ProjectSearchQuery
+	calls   int
+}
+
+func (r *fakeSearchRepo) Search(query entities.ProjectSearchQuery) ([]entities.ProjectSearchResult, error) {
+	r.query = query
+	r.calls++
+	return r.results, nil
+}
+
+func TestSearchProjects_TermsAndHighlights(t *testing.T) {
+	repo := &fakeSearchRepo{results: []entities.ProjectSearchResult{
+		{Project: entities.Project{Id: 1, NombreProyecto: "Levantamiento topográfico Xochimilco", Descripcion: "Medición de canales y chinampas"}},
+		{Project: entities.Project{Id: 2, NombreProyecto: "Parque industrial", Descripcion: "Estudio TOPOGRAFICO para naves y vialidades"}},
+	}}
+	results, err := NewSearchProjectsUseCase(repo).Execute(entities.ProjectSearchQuery{Text: "  Topografico ", Limit: 1000})
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if len(repo.query.Terms) != 1 || repo.query.Terms[0] != "topografico" {
+		t.Errorf("términos inesperados: %v", repo.query.Terms)
+	}
+	if repo.query.Limit != maxPageLimit {
+		t.Errorf("limit esperado %d, obtenido %d", maxPageLimit, repo.query.Limit)
+	}
+	if got := results[0].Highlights["nombre"]; got != "Levantamiento <mark>topográfico</mark> Xochimilco" {
+		t.Errorf("resaltado inesperado: %q", got)
+	}
+	if _, ok := results[0].Highlights["descripcion"]; ok {
+		t.Error("la descripción sin coincidencias no debería resaltarse")
+	}
+	if got := results[1].Highlights["descripcion"]; !strings.Contains(got, "<mark>TOPOGRAFICO</mark>") {
+		t.Errorf("resaltado de descripción inesperado: %q", got)
+	}
+}
+
+func TestSearchProjects_EmptyQuery(t *testing.T) {
+	repo := &fakeSearchRepo{}
+	if _, err := NewSearchProjectsUseCase(repo).Execute(entities.ProjectSearchQuery{Text: "   "}); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("se esperaba ErrInvalidFilter, obtenido %v", err)
+	}
+	if repo.calls != 0 {
+		t.Error("no se debería consultar el repositorio con una búsqueda vacía")
+	}
+}
+
+// ============================================================================
+// Consultas geoespaciales
+// ============================================================================
+
+func TestWithDistances_Haversine(t *testing.T) {
+	// Zócalo de la Ciudad de México a Ciudad Universitaria: ~12.5 km
+	projects := []entities.Project{{Id: 1, Lat: 19.3320, Lng: -99.1870}}
+	results := withDistances(projects, 19.4326, -99.1332)
+	if got := results[0].DistanceMeters; got < 12400 || got > 12650 {
+		t.Errorf("distancia fuera de lo esperado: %.0f m", got)
+	}
+}
+
+func TestGetProjectsNear_InvalidInput(t *testing.T) {
+	uc := NewGetProjectsNearUseCase(nil)
+	if _, err := uc.Execute(500, -99, 1000, 10); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("latitud inválida: se esperaba ErrInvalidFilter, obtenido %v", err)
+	}
+	if _, err := uc.Execute(19, -99, 0, 10); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("radio inválido: se esperaba ErrInvalidFilter, obtenido %v", err)
+	}
+}
+
+// ============================================================================
+// Repositorios falsos
+// ============================================================================
+
+// fakeProjectRepo guarda los proyectos y su historial en memoria, numerando las revisiones igual que el
+// repositorio MySQL; también sirve como ProjectRevisionRepository. Los métodos que no implementa provocan
+// un pánico por la interfaz embebida nula, de modo que una prueba falla si el caso de uso los usa sin esperarlo
+type fakeProjectRepo struct {
+	repository.ProjectRepository
+	projects  map[int]entities.Project
+	revisions map[int][]entities.ProjectRevision
+	nextId    int
+	saves     int
+}
+
+func newFakeProjectRepo(projects ...entities.Project) *fakeProjectRepo {
+	repo := &fakeProjectRepo{projects: make(map[int]entities.Project), revisions: make(map[int][]entities.ProjectRevision), nextId: 1}
+	for _, project := range projects {
+		repo.projects[project.Id] = project
+		repo.nextId = max(repo.nextId, project.Id+1)
+	}
+	return repo
+}
+
+func (r *fakeProjectRepo) Save(project entities.Project, revision entities.ProjectRevision) (int, error) {
+	r.saves++
+	project.Id = r.nextId
+	project.Version = 1
+	r.nextId++
+	r.projects[project.Id] = project
+	r.revisions[project.Id] = append(r.revisions[project.Id], services.NewProjectRevision(entities.Project{}, project, revision))
+	return project.Id, nil
+}
+
+func (r *fakeProjectRepo) Update(project entities.Project, revision entities.ProjectRevision) error {
+	before, ok := r.projects[project.Id]
+	if !ok {
+		return entities.ErrNotFound
+	}
+	if before.Version != project.Version {
+		return entities.ErrVersionConflict
+	}
+	// Igual que el UPDATE de MySQL, el estado y las columnas calculadas no se modifican
+	project.Status, project.Address, project.PointCount = before.Status, before.Address, before.PointCount
+	project.Version++
+	r.projects[project.Id] = project
+	if len(r.revisions[project.Id]) == 0 {
+		r.revisions[project.Id] = append(r.revisions[project.Id], services.BaselineRevision(before))
+	}
+	r.revisions[project.Id] = append(r.revisions[project.Id], services.NewProjectRevision(before, project, revision))
+	return nil
+}
+
+func (r *fakeProjectRepo) FindRevisions(projectId, before, limit int) ([]entities.ProjectRevision, error) {
+	revisions := make([]entities.ProjectRevision, 0)
+	history := r.revisions[projectId]
+	for i := len(history) - 1; i >= 0 && len(revisions) < limit; i-- {
+		if before == 0 || history[i].Revision < before {
+			revisions = append(revisions, history[i])
+		}
+	}
+	return revisions, nil
+}
+
+func (r *fakeProjectRepo) FindRevision(projectId, number int) (*entities.ProjectRevision, error) {
+	for _, revision := range r.revisions[projectId] {
+		if revision.Revision == number {
+			return &revision, nil
+		}
+	}
+	return nil, entities.ErrNotFound
+}
+
+// FindByDateRange aplica el rango, el orden por fecha descendente y el límite de la consulta
+func (r *fakeProjectRepo) FindByDateRange(query entities.ProjectDateQuery) ([]entities.Project, error) {
+	projects := make([]entities.Project, 0)
+	for _, project := range r.projects {
+		if !project.Fecha.Before(query.Range.From) && (query.Range.To.IsZero() || project.Fecha.Before(query.Range.To)) {
+			projects = append(projects, project)
+		}
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		if !projects[i].Fecha.Equal(projects[j].Fecha) {
+			return projects[i].Fecha.After(projects[j].Fecha)
+		}
+		return projects[i].Id > projects[j].Id
+	})
+	return projects[:min(query.Limit, len(projects))], nil
+}
+
+func (r *fakeProjectRepo) FindById(id int) (*entities.Project, error) {
+	project, ok := r.projects[id]
+	if !ok {
+		return nil, entities.ErrNotFound
+	}
+	return &project, nil
+}
+
+func (r *fakeProjectRepo) Delete(id int) error {
+	if _, ok := r.projects[id]; !ok {
+		return entities.ErrNotFound
+	}
+	delete(r.projects, id)
+	return nil
+}
+
+// StreamFiltered recorre los proyectos en orden de Id, sin aplicar los filtros
+func (r *fakeProjectRepo) StreamFiltered(filter entities.ProjectFilter, fn func(entities.Project) error) error {
+	ids := make([]int, 0, len(r.projects))
+	for id := range r.projects {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		if err := fn(r.projects[id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeCategoryRepo) FindAll() ([]entities.Category, error) {
+	return r.categories, nil
+}
+
+// fakeCategoryRepo resuelve las categorías por id y por slug desde una lista fija
+type fakeCategoryRepo struct {
+	repository.CategoryRepository
+	categories []entities.Category
+}
+
+func (r *fakeCategoryRepo) FindById(id int) (*entities.Category, error) {
+	for _, category := range r.categories {
+		if category.Id == id {
+			return &category, nil
+		}
+	}
+	return nil, entities.ErrNotFound
+}
+
+func (r *fakeCategoryRepo) FindBySlug(slug string) (*entities.Category, error) {
+	for _, category := range r.categories {
+		if category.Slug == slug {
+			return &category, nil
+		}
+	}
+	return nil, entities.ErrNotFound
+}
+
+func newFakeCategoryRepo() *fakeCategoryRepo {
+	return &fakeCategoryRepo{categories: []entities.Category{
+		{Id: 1, Nombre: "Topografía", Slug: "topografia", Color: "#E6194B"},
+		{Id: 2, Nombre: "Catastro", Slug: "catastro", Color: "#3cb44b"},
+	}}
+}
+
+// ============================================================================
+// GeoJSON
+// ============================================================================
+
+func TestFeatureToProject(t *testing.T) {
+	feature := entities.GeoJSONFeature{
+		Type:       "Feature",
+		Geometry:   &entities.GeoJSONGeometry{Type: "Point", Coordinates: []byte(`[-99.1332, 19.4326]`)},
+		Properties: map[string]interface{}{"nombreProyecto": "Zócalo", "categoria": "Topografía", "fecha": "2025-03-10T09:30:00"},
+	}
+	loc, _ := time.LoadLocation("America/Mexico_City")
+	project, err := services.FeatureToProject(feature, 7, loc)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if project.Lat != 19.4326 || project.Lng != -99.1332 || project.UserId != 7 {
+		t.Errorf("proyecto inesperado: %+v", project)
+	}
+	if want := time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC); !project.Fecha.Equal(want) {
+		t.Errorf("fecha esperada %s, obtenida %s", want, project.Fecha)
+	}
+
+	feature.Geometry.Coordinates = []byte(`[19.4326, -199.1332]`)
+	if _, err := services.FeatureToProject(feature, 7, loc); err == nil {
+		t.Error("se esperaba error por coordenadas fuera de rango")
+	}
+}
+
+func TestImportGeoJSON_Ownership(t *testing.T) {
+	point := func(props string) entities.GeoJSONFeature {
+		feature := entities.GeoJSONFeature{
+			Type:     "Feature",
+			Geometry: &entities.GeoJSONGeometry{Type: "Point", Coordinates: []byte(`[-99.1332, 19.4326]`)},
+		}
+		if err := json.Unmarshal([]byte(props), &feature.Properties); err != nil {
+			t.Fatalf("propiedades inválidas: %v", err)
+		}
+		return feature
+	}
+	collection := entities.GeoJSONFeatureCollection{Type: "FeatureCollection", Features: []entities.GeoJSONFeature{
+		point(`{"nombreProyecto": "Propio", "categoria": "Topografía", "fecha": "2025-11-15"}`),
+		point(`{"nombreProyecto": "Ajeno", "categoria": "Topografía", "fecha": "2025-11-15", "userId": 9}`),
+	}}
+
+	repo := newFakeProjectRepo()
+	uc := NewImportProjectsGeoJSONUseCase(repo, newFakeCategoryRepo(), nil)
+	if _, err := uc.Execute(collection, 0, false, time.UTC); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba exigir un usuario autenticado, obtenido %v", err)
+	}
+
+	report, err := uc.Execute(collection, 7, false, time.UTC)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if report.Created != 1 || report.Results[1].Success || repo.projects[report.Results[0].ProjectId].UserId != 7 {
+		t.Errorf("un usuario solo debería importar proyectos propios: %+v", report)
+	}
+
+	report, err = uc.Execute(collection, 7, true, time.UTC)
+	if err != nil || report.Created != 2 || repo.projects[report.Results[1].ProjectId].UserId != 9 {
+		t.Errorf("un administrador debería poder asignar otro dueño: %+v (%v)", report, err)
+	}
+}
+
+// ============================================================================
+// KML, KMZ y GPX
+// ============================================================================
+
+// updateGolden regenera los archivos esperados de testdata: go test ./Projects/application -update
+var updateGolden = flag.Bool("update", false, "regenera los archivos golden de testdata")
+
+// assertGolden compara la salida con testdata/name
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("no se pudo escribir %s: %v", path, err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("no se pudo leer %s: %v", path, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s no coincide con la salida:\n%s", name, got)
+	}
+}
+
+// newExportFixture incluye caracteres que deben escaparse y proyectos junto al antimeridiano
+func newExportFixture() *fakeProjectRepo {
+	fecha := time.Date(2025, 11, 15, 6, 0, 0, 0, time.UTC)
+	return newFakeProjectRepo(
+		entities.Project{Id: 1, NombreProyecto: `Deslinde "Los <Pinos>" & anexos`, Descripcion: "Cota < 5 m ]]> fin", Img: "https://img.example/a.jpg?w=1&h=2", Categoria: "Topografía", CategoryId: 1, Lat: 19.4326, Lng: -99.1332, UserId: 7, Fecha: fecha},
+		entities.Project{Id: 2, NombreProyecto: "Taveuni este", Categoria: "Catastro", CategoryId: 2, Lat: -16.8, Lng: 179.9999999, UserId: 8, Fecha: fecha},
+		entities.Project{Id: 3, NombreProyecto: "Taveuni oeste", Categoria: "Batimetría", CategoryId: 99, Lat: -16.8, Lng: 180, UserId: 8, Fecha: fecha},
+	)
+}
+
+func TestExportKMLGolden(t *testing.T) {
+	uc := NewExportProjectsKMLUseCase(newExportFixture(), newFakeCategoryRepo())
+
+	var kml bytes.Buffer
+	if err := uc.Execute(entities.ProjectFilter{}, &kml, false); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	assertGolden(t, "projects.kml", kml.Bytes())
+	if err := xml.Unmarshal(kml.Bytes(), new(struct{})); err != nil {
+		t.Errorf("el KML no es XML válido: %v", err)
+	}
+
+	// El KMZ contiene exactamente el mismo doc.kml
+	var kmz bytes.Buffer
+	if err := uc.Execute(entities.ProjectFilter{}, &kmz, true); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(kmz.Bytes()), int64(kmz.Len()))
+	if err != nil || len(archive.File) != 1 || archive.File[0].Name != "doc.kml" {
+		t.Fatalf("KMZ inesperado: %v", err)
+	}
+	doc, _ := archive.File[0].Open()
+	content, _ := io.ReadAll(doc)
+	assertGolden(t, "projects.kml", content)
+}
+
+func TestExportGPXGolden(t *testing.T) {
+	var gpx bytes.Buffer
+	if err := NewExportProjectsGPXUseCase(newExportFixture()).Execute(entities.ProjectFilter{}, &gpx); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	assertGolden(t, "projects.gpx", gpx.Bytes())
+	if err := xml.Unmarshal(gpx.Bytes(), new(struct{})); err != nil {
+		t.Errorf("el GPX no es XML válido: %v", err)
+	}
+}
+
+// ============================================================================
+// Fechas
+// ============================================================================
+
+func TestParseProjectDate(t *testing.T) {
+	loc, err := entities.LoadTimezone("America/Mexico_City")
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	cases := map[string]time.Time{
+		"2025-11-15":                time.Date(2025, 11, 15, 6, 0, 0, 0, time.UTC),
+		"2025-11-15T20:00:00":       time.Date(2025, 11, 16, 2, 0, 0, 0, time.UTC),
+		"2025-11-15T20:00:00Z":      time.Date(2025, 11, 15, 20, 0, 0, 0, time.UTC),
+		"2025-11-15T20:00:00+02:00": time.Date(2025, 11, 15, 18, 0, 0, 0, time.UTC),
+	}
+	for value, want := range cases {
+		got, err := entities.ParseProjectDate(value, loc)
+		if err != nil {
+			t.Errorf("%s: error inesperado: %v", value, err)
+			continue
+		}
+		if !got.Equal(want) || got.Location() != time.UTC {
+			t.Errorf("%s: esperado %s, obtenido %s", value, want, got)
+		}
+	}
+
+	for _, value := range []string{"", "15/11/2025", "2025-13-01"} {
+		if _, err := entities.ParseProjectDate(value, loc); err == nil {
+			t.Errorf("%q: se esperaba error", value)
+		}
+	}
+	if _, err := entities.LoadTimezone("Mars/Olympus_Mons"); err == nil {
+		t.Error("se esperaba error por zona horaria desconocida")
+	}
+}
+
+func TestResolveDateRange(t *testing.T) {
+	loc, _ := time.LoadLocation("America/Mexico_City")
+	now := time.Date(2025, 3, 15, 3, 0, 0, 0, time.UTC) // 14 de marzo, 21:00 en Ciudad de México
+
+	cases := map[string]struct {
+		params   entities.DateRangeParams
+		from, to time.Time
+	}{
+		"from/to inclusivo": {
+			entities.DateRangeParams{From: "2025-01-01", To: "2025-01-31"},
+			time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC), time.Date(2025, 2, 1, 6, 0, 0, 0, time.UTC),
+		},
+		"últimos 7 días": {
+			entities.DateRangeParams{Last: "7d"},
+			time.Date(2025, 3, 8, 6, 0, 0, 0, time.UTC), time.Date(2025, 3, 15, 6, 0, 0, 0, time.UTC),
+		},
+		"último mes de calendario": {
+			entities.DateRangeParams{Last: "1m"},
+			time.Date(2025, 2, 15, 6, 0, 0, 0, time.UTC), time.Date(2025, 3, 15, 6, 0, 0, 0, time.UTC),
+		},
+		"último año": {
+			entities.DateRangeParams{Last: "1y"},
+			time.Date(2024, 3, 15, 6, 0, 0, 0, time.UTC), time.Date(2025, 3, 15, 6, 0, 0, 0, time.UTC),
+		},
+		"mes": {
+			entities.DateRangeParams{Month: "2025-02"},
+			time.Date(2025, 2, 1, 6, 0, 0, 0, time.UTC), time.Date(2025, 3, 1, 6, 0, 0, 0, time.UTC),
+		},
+		"año": {
+			entities.DateRangeParams{Year: "2024"},
+			time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC),
+		},
+	}
+	for name, tc := range cases {
+		got, err := services.ResolveDateRange(tc.params, now, loc)
+		if err != nil {
+			t.Errorf("%s: error inesperado: %v", name, err)
+			continue
+		}
+		if !got.From.Equal(tc.from) || !got.To.Equal(tc.to) {
+			t.Errorf("%s: esperado [%s, %s), obtenido [%s, %s)", name, tc.from, tc.to, got.From, got.To)
+		}
+	}
+
+	invalid := []entities.DateRangeParams{
+		{From: "2025-02-01", To: "2025-01-01"},
+		{Last: "30d", Month: "2025-01"},
+		{Last: "treinta"},
+		{Last: "0d"},
+		{Last: "11y"},
+		{Month: "2025-13"},
+		{Year: "25"},
+	}
+	for _, params := range invalid {
+		if _, err := services.ResolveDateRange(params, now, loc); !errors.Is(err, entities.ErrInvalidFilter) {
+			t.Errorf("%+v: se esperaba ErrInvalidFilter, obtenido %v", params, err)
+		}
+	}
+}
+
+// ============================================================================
+// Historial de revisiones
+// ============================================================================
+
+func TestRestoreProjectRevision(t *testing.T) {
+	repo := newFakeProjectRepo()
+	id, err := repo.Save(entities.Project{NombreProyecto: "Deslinde", Categoria: "Topografía", CategoryId: 1, Lat: 19.4, Lng: -99.1, UserId: 7,
+		Fecha: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)}, entities.ProjectRevision{Action: entities.RevisionActionCreate, EditorId: 7})
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	edited := repo.projects[id]
+	edited.NombreProyecto = "Deslinde norte"
+	if err := repo.Update(edited, entities.ProjectRevision{Action: entities.RevisionActionUpdate, EditorId: 7}); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+
+	uc := NewRestoreProjectRevisionUseCase(repo, repo, newFakeCategoryRepo(), nil)
+	// Una versión desactualizada no restaura ni agrega revisiones
+	if _, err := uc.Execute(id, 1, 1, 7, false); !errors.Is(err, entities.ErrVersionConflict) {
+		t.Fatalf("se esperaba ErrVersionConflict, obtenido %v", err)
+	}
+	if _, err := uc.Execute(id, 5, 2, 7, false); !errors.Is(err, entities.ErrNotFound) {
+		t.Fatalf("se esperaba ErrNotFound para una revisión inexistente, obtenido %v", err)
+	}
+
+	// Solo el dueño o un administrador pueden restaurar
+	if _, err := uc.Execute(id, 1, 2, 9, false); !errors.Is(err, entities.ErrForbidden) {
+		t.Fatalf("se esperaba ErrForbidden para otro usuario, obtenido %v", err)
+	}
+	restored, err := uc.Execute(id, 1, 2, 9, true)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if restored.NombreProyecto != "Deslinde" || restored.Version != 3 {
+		t.Fatalf("proyecto restaurado inesperado: %q versión %d", restored.NombreProyecto, restored.Version)
+	}
+
+	history := repo.revisions[id]
+	if len(history) != 3 {
+		t.Fatalf("se esperaban 3 revisiones, obtenidas %d", len(history))
+	}
+	last := history[2]
+	if last.Revision != 3 || last.Action != entities.RevisionActionRestore || last.RestoredFrom != 1 || last.EditorId != 9 {
+		t.Errorf("revisión de la restauración inesperada: %+v", last)
+	}
+	if len(last.ChangedFields) != 1 || last.ChangedFields[0] != "NombreProyecto" {
+		t.Errorf("campos modificados inesperados: %v", last.ChangedFields)
+	}
+	if last.Snapshot.NombreProyecto != "Deslinde" || last.Snapshot.Version != 3 {
+		t.Errorf("copia inesperada en la revisión: %+v", last.Snapshot)
+	}
+}
+
+func TestRestoreProjectRevision_BaselineForLegacyProject(t *testing.T) {
+	// Un proyecto sin historial guarda su estado anterior como revisión inicial con su versión actual
+	legacy := entities.Project{Id: 4, NombreProyecto: "Lote 12", Categoria: "Catastro", CategoryId: 2, Lat: 19.4, Lng: -99.1, UserId: 7,
+		Fecha: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Version: 3}
+	repo := newFakeProjectRepo(legacy)
+	edited := legacy
+	edited.Descripcion = "Medición corregida"
+	if err := repo.Update(edited, entities.ProjectRevision{Action: entities.RevisionActionUpdate, EditorId: 7}); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+
+	history := repo.revisions[4]
+	if len(history) != 2 || history[0].Action != entities.RevisionActionBaseline || history[0].Revision != 3 || history[1].Revision != 4 {
+		t.Fatalf("historial inesperado: %+v", history)
+	}
+
+	restored, err := NewRestoreProjectRevisionUseCase(repo, repo, newFakeCategoryRepo(), nil).Execute(4, 3, 0, 7, false)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if restored.Descripcion != "" || restored.Version != 5 || repo.revisions[4][2].RestoredFrom != 3 {
+		t.Errorf("restauración inesperada: %+v", restored)
+	}
+}
+
+func TestProjectWrites_Ownership(t *testing.T) {
+	owned := entities.Project{Id: 1, NombreProyecto: "Deslinde", Categoria: "Topografía", CategoryId: 1, Lat: 19.4, Lng: -99.1, UserId: 7,
+		Fecha: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), Version: 1}
+	repo := newFakeProjectRepo(owned)
+	patch := NewPatchProjectUseCase(repo, newFakeCategoryRepo(), nil)
+
+	if _, err := patch.Execute(1, []byte(`{"UserId": 9}`), 0, 9, false, time.UTC); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("el dueño no debería poder cambiarse con PATCH, obtenido %v", err)
+	}
+	if _, err := patch.Execute(1, []byte(`{"NombreProyecto": "Ajeno"}`), 0, 9, false, time.UTC); !errors.Is(err, entities.ErrForbidden) {
+		t.Errorf("se esperaba ErrForbidden al editar un proyecto ajeno, obtenido %v", err)
+	}
+	if _, err := patch.Execute(1, []byte(`{"NombreProyecto": "Deslinde norte"}`), 0, 7, false, time.UTC); err != nil {
+		t.Fatalf("el dueño debería poder editar: %v", err)
+	}
+
+	update := NewUpdateProjectUseCase(repo, newFakeCategoryRepo(), nil, nil, nil, nil)
+	takeover := repo.projects[1]
+	takeover.UserId = 9
+	if _, err := update.Execute(takeover, "", nil, 9, false); !errors.Is(err, entities.ErrForbidden) {
+		t.Errorf("se esperaba ErrForbidden al actualizar un proyecto ajeno, obtenido %v", err)
+	}
+	updated, err := update.Execute(takeover, "", nil, 3, true)
+	if err != nil || updated.UserId != 7 {
+		t.Errorf("un administrador actualiza sin cambiar el dueño: %+v (%v)", updated, err)
+	}
+}
+
+func TestGetProjectHistory_Paging(t *testing.T) {
+	repo := newFakeProjectRepo(entities.Project{Id: 1, NombreProyecto: "Deslinde"}, entities.Project{Id: 2, NombreProyecto: "Sin historial"})
+	// Los cambios de estado incrementan la versión sin revisión, por lo que los números pueden saltar
+	for _, number := range []int{1, 2, 4, 5, 7} {
+		repo.revisions[1] = append(repo.revisions[1], entities.ProjectRevision{ProjectId: 1, Revision: number})
+	}
+	uc := NewGetProjectHistoryUseCase(repo, repo)
+
+	var got []int
+	before := 0
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("la paginación no termina")
+		}
+		page, err := uc.Execute(1, before, 2)
+		if err != nil {
+			t.Fatalf("error inesperado: %v", err)
+		}
+		if page.Limit != 2 || len(page.Revisions) > 2 {
+			t.Fatalf("página inesperada: %+v", page)
+		}
+		for _, revision := range page.Revisions {
+			got = append(got, revision.Revision)
+		}
+		if page.NextBefore == 0 {
+			break
+		}
+		before = page.NextBefore
+	}
+	if fmt.Sprint(got) != "[7 5 4 2 1]" {
+		t.Errorf("revisiones inesperadas: %v", got)
+	}
+
+	page, err := uc.Execute(1, 0, 0)
+	if err != nil || page.Limit != defaultPageLimit || len(page.Revisions) != 5 || page.NextBefore != 0 {
+		t.Errorf("página por defecto inesperada: %+v, %v", page, err)
+	}
+	if page, err := uc.Execute(2, 0, 10); err != nil || len(page.Revisions) != 0 {
+		t.Errorf("un proyecto sin historial debería devolver una página vacía: %+v, %v", page, err)
+	}
+	if _, err := uc.Execute(3, 0, 10); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("se esperaba ErrNotFound, obtenido %v", err)
+	}
+	if _, err := uc.Execute(1, -1, 10); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("se esperaba rechazar before negativo, obtenido %v", err)
+	}
+}
+
+// ============================================================================
+// Actualización parcial y control de concurrencia
+// ============================================================================
+
+func TestCheckExpectedVersion(t *testing.T) {
+	current := entities.Project{Id: 4, Version: 3}
+	if version, err := checkExpectedVersion(current, 0); err != nil || version != 3 {
+		t.Errorf("If-Match: * debería aceptar la versión actual, obtenido %d, %v", version, err)
+	}
+	if version, err := checkExpectedVersion(current, 3); err != nil || version != 3 {
+		t.Errorf("versión coincidente rechazada: %d, %v", version, err)
+	}
+	if _, err := checkExpectedVersion(current, 2); !errors.Is(err, entities.ErrVersionConflict) {
+		t.Errorf("se esperaba ErrVersionConflict, obtenido %v", err)
+	}
+}
+
+// ============================================================================
+// Catálogo de categorías
+// ============================================================================
+
+func TestApplyCategoryOverrides(t *testing.T) {
+	categories := []entities.Category{
+		{Id: 1, Slug: "catastro", Nombre: "Catastro", Color: "#E6194B"},
+		{Id: 2, Slug: "topografia", Nombre: "Topografía", Color: "#3CB44B"},
+	}
+	overrides := []entities.CategoryOverride{
+		{CategoryId: 1, OrgId: 7, Hidden: true},
+		{CategoryId: 2, OrgId: 7, Nombre: "Levantamientos"},
+	}
+
+	got := services.ApplyCategoryOverrides(categories, overrides)
+	if len(got) != 1 {
+		t.Fatalf("se esperaba ocultar una categoría, obtenidas %d", len(got))
+	}
+	if got[0].Nombre != "Levantamientos" || got[0].Color != "#3CB44B" || !got[0].Overridden {
+		t.Errorf("personalización mal aplicada: %+v", got[0])
+	}
+}
+
+// ============================================================================
+// Etiquetas
+// ============================================================================
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := services.NormalizeTags([]string{" Temporada  Seca ", "temporada seca", "", "Cliente ACME"})
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if len(tags) != 2 {
+		t.Fatalf("se esperaban 2 etiquetas sin repetir, obtenidas %+v", tags)
+	}
+	if tags[0].Nombre != "Temporada Seca" || tags[0].Slug != "temporada-seca" || tags[1].Slug != "cliente-acme" {
+		t.Errorf("etiquetas inesperadas: %+v", tags)
+	}
+
+	if _, err := services.NormalizeTags([]string{"???"}); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba ErrInvalidInput para una etiqueta sin letras, obtenido %v", err)
+	}
+}
+
+func TestNormalizeProjectFilter_Tags(t *testing.T) {
+	filter := entities.ProjectFilter{Tags: []string{"Temporada Seca", "GPS"}}
+	if err := normalizeProjectFilter(&filter); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if filter.TagMatch != entities.TagMatchAll || len(filter.Tags) != 2 || filter.Tags[0] != "temporada-seca" || filter.Tags[1] != "gps" {
+		t.Errorf("filtro de etiquetas inesperado: %+v", filter)
+	}
+
+	invalid := entities.ProjectFilter{Tags: []string{"gps"}, TagMatch: "some"}
+	if err := normalizeProjectFilter(&invalid); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("se esperaba ErrInvalidFilter para tagMatch inválido, obtenido %v", err)
+	}
+}
+
+// ============================================================================
+// Galería de imágenes
+// ============================================================================
+
+func TestValidateMediaUploads(t *testing.T) {
+	lat, lng := 19.43, -99.13
+	if err := services.ValidateMediaUploads([]entities.MediaUpload{{Caption: "Vista norte", Lat: &lat, Lng: &lng}}); err != nil {
+		t.Errorf("error inesperado: %v", err)
+	}
+	if err := services.ValidateMediaUploads([]entities.MediaUpload{{Lat: &lat}}); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba ErrInvalidInput sin longitud, obtenido %v", err)
+	}
+	if err := services.ValidateMediaUploads(make([]entities.MediaUpload, services.MaxMediaPerUpload+1)); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba ErrInvalidInput por exceso de imágenes, obtenido %v", err)
+	}
+}
+
+func TestValidateMediaOrder(t *testing.T) {
+	current := []entities.ProjectMedia{{Id: 3}, {Id: 4}, {Id: 5}}
+	if err := services.ValidateMediaOrder(current, []int{5, 3, 4}); err != nil {
+		t.Errorf("error inesperado: %v", err)
+	}
+	for _, ids := range [][]int{{5, 3}, {5, 3, 3}, {5, 3, 9}} {
+		if err := services.ValidateMediaOrder(current, ids); !errors.Is(err, entities.ErrInvalidInput) {
+			t.Errorf("%v: se esperaba ErrInvalidInput, obtenido %v", ids, err)
+		}
+	}
+}
+
+// ============================================================================
+// Documentos adjuntos
+// ============================================================================
+
+// fakeDocumentRepo lista los documentos de cada proyecto desde un mapa fijo
+type fakeDocumentRepo struct {
+	repository.ProjectDocumentRepository
+	documents map[int][]entities.ProjectDocument
+}
+
+func (r *fakeDocumentRepo) FindByProject(projectId int) ([]entities.ProjectDocument, error) {
+	return r.documents[projectId], nil
+}
+
+// fakeDocumentStorage registra las ubicaciones eliminadas
+type fakeDocumentStorage struct {
+	services.IDocumentStorage
+	deleted []string
+}
+
+func (s *fakeDocumentStorage) Delete(location string) error {
+	s.deleted = append(s.deleted, location)
+	return nil
+}
+
+// fakeBulkRepo elimina los proyectos del repositorio falso o devuelve err sin modificar ninguno
+type fakeBulkRepo struct {
+	repository.ProjectBulkRepository
+	projects *fakeProjectRepo
+	err      error
+}
+
+func (r *fakeBulkRepo) DeleteProjects(targets []entities.BulkTarget) error {
+	if r.err != nil {
+		return r.err
+	}
+	for _, target := range targets {
+		delete(r.projects.projects, target.Id)
+	}
+	return nil
+}
+
+func TestDeleteProject_RemovesDocumentFiles(t *testing.T) {
+	documents := &fakeDocumentRepo{documents: map[int][]entities.ProjectDocument{
+		1: {{Id: 1, ProjectId: 1, Location: "1/plano.pdf"}, {Id: 2, ProjectId: 1, Location: "1/puntos.csv"}},
+		2: {{Id: 3, ProjectId: 2, Location: "2/plano.dxf"}},
+		3: {{Id: 4, ProjectId: 3, Location: "3/plano.pdf"}},
+	}}
+	repo := newFakeProjectRepo(entities.Project{Id: 1, UserId: 7}, entities.Project{Id: 2, UserId: 7}, entities.Project{Id: 3, UserId: 7})
+
+	storage := &fakeDocumentStorage{}
+	if err := NewDeleteProjectUseCase(repo, documents, storage).Execute(1); err != nil {
+		t.Fatalf("no se pudo eliminar el proyecto: %v", err)
+	}
+	if strings.Join(storage.deleted, ",") != "1/plano.pdf,1/puntos.csv" {
+		t.Errorf("archivos eliminados inesperados: %v", storage.deleted)
+	}
+
+	// Si la transacción masiva falla los archivos se conservan
+	storage = &fakeDocumentStorage{}
+	bulk := &fakeBulkRepo{projects: repo, err: entities.ErrVersionConflict}
+	uc := NewBulkProjectsUseCase(repo, bulk, nil, services.DefaultProjectStatusWorkflow(), documents, storage)
+	op := entities.BulkOperation{Action: entities.BulkActionDelete, ProjectIds: []int{2, 3}}
+	if _, err := uc.Execute(op, 7, false); !errors.Is(err, entities.ErrVersionConflict) || len(storage.deleted) != 0 {
+		t.Errorf("se esperaba conservar los archivos tras un conflicto: %v, %v", err, storage.deleted)
+	}
+
+	bulk.err = nil
+	if report, err := uc.Execute(op, 7, false); err != nil || !report.Applied {
+		t.Fatalf("no se pudieron eliminar los proyectos: %+v, %v", report, err)
+	}
+	if strings.Join(storage.deleted, ",") != "2/plano.dxf,3/plano.pdf" {
+		t.Errorf("archivos eliminados inesperados: %v", storage.deleted)
+	}
+}
+
+// ============================================================================
+// Puntos de medición
+// ============================================================================
+
+func TestValidateMeasurementBatch(t *testing.T) {
+	elevation, accuracy := 2240.5, 0.02
+	measuredAt := time.Date(2025, 11, 15, 10, 2, 0, 0, time.FixedZone("CST", -6*3600))
+	points := []entities.Measurement{
+		{Lat: 19.43, Lng: -99.13, Elevation: &elevation, Accuracy: &accuracy, MeasuredAt: measuredAt, Instrument: "  GNSS R10 "},
+	}
+	if err := services.ValidateMeasurementBatch(points); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if points[0].Instrument != "GNSS R10" || points[0].MeasuredAt.Location() != time.UTC {
+		t.Errorf("punto no normalizado: %+v", points[0])
+	}
+
+	negative := -1.0
+	invalid := []entities.Measurement{
+		{Lat: 19.43, Lng: -99.13, MeasuredAt: measuredAt},
+		{Lat: 19.43, Lng: -99.13, MeasuredAt: measuredAt, Accuracy: &negative},
+	}
+	err := services.ValidateMeasurementBatch(invalid)
+	if !errors.Is(err, entities.ErrInvalidInput) || !strings.Contains(err.Error(), "punto 2") {
+		t.Errorf("se esperaba ErrInvalidInput en el punto 2, obtenido %v", err)
+	}
+	if err := services.ValidateMeasurementBatch([]entities.Measurement{{Lat: 19.43, Lng: -99.13}}); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba ErrInvalidInput sin measured_at, obtenido %v", err)
+	}
+
+	// accuracy es DECIMAL(8, 3): 99999.999 es el máximo y 99999.9996 se redondearía a 100000
+	for _, value := range []float64{100000, 99999.9996, math.Inf(1)} {
+		accuracy := value
+		point := []entities.Measurement{{Lat: 19.43, Lng: -99.13, MeasuredAt: measuredAt, Accuracy: &accuracy}}
+		if err := services.ValidateMeasurementBatch(point); !errors.Is(err, entities.ErrInvalidInput) {
+			t.Errorf("precisión %v: se esperaba ErrInvalidInput, obtenido %v", value, err)
+		}
+	}
+	maxAccuracy := 99999.999
+	if err := services.ValidateMeasurementBatch([]entities.Measurement{{Lat: 19.43, Lng: -99.13, MeasuredAt: measuredAt, Accuracy: &maxAccuracy}}); err != nil {
+		t.Errorf("precisión máxima: error inesperado: %v", err)
+	}
+}
+
+// ============================================================================
+// Geometría del proyecto
+// ============================================================================
+
+func TestApplyProjectGeometry(t *testing.T) {
+	// Cuadrado de 0.001° en el ecuador con un vértice repetido
+	wkt := "SRID=4326;POLYGON ((0 0, 0.001 0, 0.001 0, 0.001 0.001, 0 0.001, 0 0))"
+	geometry, err := services.ParseGeometry(wkt)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	project := entities.Project{Lat: 19.43, Lng: -99.13, Geometry: geometry}
+	if err := applyProjectGeometry(&project); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if len(project.Geometry.Coordinates[0]) != 5 {
+		t.Errorf("se esperaba quitar el vértice repetido: %v", project.Geometry.Coordinates[0])
+	}
+	// En el ecuador un grado de longitud mide a y uno de latitud a(1-e²) sobre el elipsoide WGS84
+	eastWest := 6378137 * math.Pi / 180 * 0.001
+	northSouth := 6335439.327 * math.Pi / 180 * 0.001
+	if math.Abs(project.AreaM2-eastWest*northSouth) > 1 || math.Abs(project.PerimeterM-2*(eastWest+northSouth)) > 0.01 {
+		t.Errorf("área o perímetro inesperados: %v m², %v m", project.AreaM2, project.PerimeterM)
+	}
+	if math.Abs(project.Lat-0.0005) > 1e-9 || math.Abs(project.Lng-0.0005) > 1e-9 {
+		t.Errorf("centroide inesperado: %v, %v", project.Lat, project.Lng)
+	}
+
+	fromGeoJSON, err := services.ParseGeometry(`{"type": "Feature", "geometry": {"type": "Polygon", "coordinates": [[[0, 0], [0.001, 0], [0.001, 0.001], [0, 0.001], [0, 0]]]}}`)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if services.GeometryToWKT(fromGeoJSON) != services.GeometryToWKT(project.Geometry) {
+		t.Errorf("GeoJSON y WKT deberían dar la misma geometría: %s", services.GeometryToWKT(fromGeoJSON))
+	}
+
+	line := entities.Project{Geometry: &entities.ProjectGeometry{Type: entities.GeometryLineString, Coordinates: [][]entities.Position{{{0, 0}, {0, 0.002}}}}}
+	if err := applyProjectGeometry(&line); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if line.AreaM2 != 0 || math.Abs(line.PerimeterM-2*northSouth) > 0.01 || math.Abs(line.Lat-0.001) > 1e-9 {
+		t.Errorf("línea inesperada: %+v", line)
+	}
+
+	invalid := map[string]string{
+		"anillo abierto":       "POLYGON ((0 0, 1 0, 1 1, 0 1))",
+		"moño autointersecado": "POLYGON ((0 0, 1 1, 1 0, 0 1, 0 0))",
+		"pico sobre un lado":   "POLYGON ((0 0, 2 0, 1 0, 1 1, 0 0))",
+		"hueco fuera":          "POLYGON ((0 0, 1 0, 1 1, 0 1, 0 0), (2 2, 3 2, 3 3, 2 2))",
+		"latitud fuera":        `{"type": "LineString", "coordinates": [[0, 0], [0, 91]]}`,
+		"punto":                `{"type": "Point", "coordinates": [0, 0]}`,
+		"WKT mal formado":      "POLYGON (0 0, 1 0, 1 1, 0 0)",
+	}
+	for name, input := range invalid {
+		geometry, err := services.ParseGeometry(input)
+		if err == nil {
+			err = applyProjectGeometry(&entities.Project{Geometry: geometry})
+		}
+		if !errors.Is(err, entities.ErrInvalidInput) {
+			t.Errorf("%s: se esperaba ErrInvalidInput, obtenido %v", name, err)
+		}
+	}
+}
+
+// ============================================================================
+// Sistemas de referencia
+// ============================================================================
+
+func TestApplyProjectGeometry_ProjectedCRS(t *testing.T) {
+	geometry, err := services.ParseGeometryInCRS("POLYGON ((486000 2148700, 486100 2148700, 486100 2148800, 486000 2148800, 486000 2148700))", 32614)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	project := entities.Project{Geometry: geometry}
+	if err := applyProjectGeometry(&project); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	// 100 m × 100 m en UTM; la escala de la proyección cerca del meridiano central es 0.9996
+	if math.Abs(project.AreaM2-10000/(0.9996*0.9996)) > 1 || math.Abs(project.Lat-19.4330) > 0.001 {
+		t.Errorf("parcela reproyectada inesperada: %v m² en %v, %v", project.AreaM2, project.Lat, project.Lng)
+	}
+
+	invalid := entities.Project{NombreProyecto: "P", Fecha: time.Now(), Categoria: "C", UserId: 1, Lat: 500}
+	if err := validateProjectFields(invalid); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba rechazar la latitud 500, obtenido %v", err)
+	}
+}
+
+// ============================================================================
+// Estado del proyecto
+// ============================================================================
+
+func TestProjectStatusWorkflow(t *testing.T) {
+	workflow := services.DefaultProjectStatusWorkflow()
+
+	if err := services.CheckStatusTransition(workflow, entities.StatusDraft, entities.StatusPlanned); err != nil {
+		t.Errorf("draft -> planned debería estar permitido: %v", err)
+	}
+	if err := services.CheckStatusTransition(workflow, entities.StatusDraft, entities.StatusDelivered); !errors.Is(err, entities.ErrConflict) {
+		t.Errorf("draft -> delivered debería rechazarse con ErrConflict, obtenido %v", err)
+	}
+	if err := services.CheckStatusTransition(workflow, entities.StatusPlanned, entities.StatusPlanned); !errors.Is(err, entities.ErrConflict) {
+		t.Errorf("se esperaba rechazar la transición al mismo estado, obtenido %v", err)
+	}
+	if err := services.CheckStatusTransition(workflow, entities.StatusDraft, "cancelled"); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba ErrInvalidInput para un estado desconocido, obtenido %v", err)
+	}
+
+	custom, err := services.ParseProjectStatusWorkflow("draft: planned ; planned:in_field,archived")
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if err := services.CheckStatusTransition(custom, entities.StatusPlanned, entities.StatusArchived); err != nil {
+		t.Errorf("planned -> archived debería estar permitido: %v", err)
+	}
+	if err := services.CheckStatusTransition(custom, entities.StatusArchived, entities.StatusDraft); !errors.Is(err, entities.ErrConflict) {
+		t.Errorf("archived no tiene salida en el flujo configurado, obtenido %v", err)
+	}
+	for _, spec := range []string{"", "draft", "draft:cancelled", "draft:draft", "draft:planned;draft:archived"} {
+		if _, err := services.ParseProjectStatusWorkflow(spec); err == nil {
+			t.Errorf("se esperaba rechazar el flujo %q", spec)
+		}
+	}
+
+	filter := entities.ProjectFilter{Statuses: []string{" In_Field", "planned"}}
+	if err := normalizeProjectFilter(&filter); err != nil || filter.Statuses[0] != entities.StatusInField {
+		t.Errorf("filtro de estados inesperado: %v (%v)", filter.Statuses, err)
+	}
+	filter = entities.ProjectFilter{Statuses: []string{"cancelled"}}
+	if err := normalizeProjectFilter(&filter); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("se esperaba ErrInvalidFilter, obtenido %v", err)
+	}
+}
+
+// ============================================================================
+// Plantillas de proyecto
+// ============================================================================
+
+// memoryTemplates es un repositorio de plantillas en memoria para aplicar plantillas sin base de datos
+type memoryTemplates map[int]entities.ProjectTemplate
+
+func (m memoryTemplates) Save(template entities.ProjectTemplate) (int, error) { return 0, nil }
+func (m memoryTemplates) FindAll() ([]entities.ProjectTemplate, error)        { return nil, nil }
+func (m memoryTemplates) Update(template entities.ProjectTemplate) error      { return nil }
+func (m memoryTemplates) Delete(id int) error                                 { return nil }
+
+func (m memoryTemplates) FindById(id int) (*entities.ProjectTemplate, error) {
+	template, ok := m[id]
+	if !ok {
+		return nil, entities.ErrNotFound
+	}
+	return &template, nil
+}
+
+func TestProjectTemplates(t *testing.T) {
+	template := entities.ProjectTemplate{Name: "  Catastral ", Checklist: []string{" Verificar vértices ", "", "Firmar acta"}}
+	if err := services.ValidateProjectTemplate(&template); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if template.Name != "Catastral" || len(template.Checklist) != 2 || template.Checklist[0] != "Verificar vértices" {
+		t.Errorf("plantilla normalizada inesperada: %+v", template)
+	}
+	if err := services.ValidateProjectTemplate(&entities.ProjectTemplate{Name: " "}); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba rechazar una plantilla sin nombre, obtenido %v", err)
+	}
+	tooMany := make([]string, services.MaxChecklistItems+1)
+	for i := range tooMany {
+		tooMany[i] = "tarea"
+	}
+	if err := services.ValidateProjectTemplate(&entities.ProjectTemplate{Name: "x", Checklist: tooMany}); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba rechazar más de %d tareas, obtenido %v", services.MaxChecklistItems, err)
+	}
+	if err := services.ValidateChecklist([]entities.ChecklistItem{{Text: " "}}); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba rechazar una tarea vacía, obtenido %v", err)
+	}
+
+	templates := memoryTemplates{7: {Id: 7, CategoryId: 3, Descripcion: "Predio: ", Checklist: template.Checklist}}
+	project := entities.Project{TemplateId: 7, Categoria: "Topografía"}
+	if err := applyProjectTemplate(templates, &project); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if project.CategoryId != 0 || project.Descripcion != "Predio: " || len(project.Checklist) != 2 || project.Checklist[0].Done {
+		t.Errorf("la plantilla no se aplicó como se esperaba: %+v", project)
+	}
+	project = entities.Project{TemplateId: 7}
+	if err := applyProjectTemplate(templates, &project); err != nil || project.CategoryId != 3 {
+		t.Errorf("se esperaba la categoría de la plantilla, obtenido %d (%v)", project.CategoryId, err)
+	}
+	if err := applyProjectTemplate(templates, &entities.Project{TemplateId: 8}); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba ErrInvalidInput para una plantilla inexistente, obtenido %v", err)
+	}
+
+	// Solo el autor o un administrador modifican o eliminan la plantilla
+	templates[7] = entities.ProjectTemplate{Id: 7, Name: "Catastral", UserId: 5}
+	update := NewUpdateProjectTemplateUseCase(templates, newFakeCategoryRepo())
+	if _, err := update.Execute(entities.ProjectTemplate{Id: 7, Name: "Mía", UserId: 9}, 9, false); !errors.Is(err, entities.ErrForbidden) {
+		t.Errorf("se esperaba ErrForbidden al modificar una plantilla ajena, obtenido %v", err)
+	}
+	if err := NewDeleteProjectTemplateUseCase(templates).Execute(7, 9, false); !errors.Is(err, entities.ErrForbidden) {
+		t.Errorf("se esperaba ErrForbidden al eliminar una plantilla ajena, obtenido %v", err)
+	}
+	if err := NewDeleteProjectTemplateUseCase(templates).Execute(7, 9, true); err != nil {
+		t.Errorf("un administrador debería poder eliminar la plantilla: %v", err)
+	}
+}
+
+// ============================================================================
+// Operaciones masivas
+// ============================================================================
+
+func TestBulkOperations(t *testing.T) {
+	ids, err := services.NormalizeBulkIds([]int{3, 1, 3, 2})
+	if err != nil || len(ids) != 3 || ids[0] != 3 || ids[2] != 2 {
+		t.Errorf("ids normalizados inesperados: %v (%v)", ids, err)
+	}
+	tooMany := make([]int, services.MaxBulkProjects+1)
+	for i := range tooMany {
+		tooMany[i] = i + 1
+	}
+	for _, invalid := range [][]int{nil, {1, 0}, tooMany} {
+		if _, err := services.NormalizeBulkIds(invalid); !errors.Is(err, entities.ErrInvalidInput) {
+			t.Errorf("se esperaba rechazar %d ids, obtenido %v", len(invalid), err)
+		}
+	}
+
+	project := entities.Project{Id: 1, UserId: 7}
+	if !services.CanModifyProject(project, 7, false) || !services.CanModifyProject(project, 9, true) {
+		t.Error("el autor y los administradores deberían poder modificar el proyecto")
+	}
+	if services.CanModifyProject(project, 9, false) || services.CanModifyProject(entities.Project{}, 0, false) {
+		t.Error("otro usuario no debería poder modificar el proyecto")
+	}
+
+	add, remove, err := normalizeBulkTags([]string{"Cliente ACME"}, []string{"temporada seca", " Temporada  Seca "})
+	if err != nil || len(add) != 1 || len(remove) != 1 || remove[0] != "temporada-seca" {
+		t.Errorf("etiquetas inesperadas: %v %v (%v)", add, remove, err)
+	}
+	if _, _, err := normalizeBulkTags(nil, nil); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba rechazar una operación sin etiquetas, obtenido %v", err)
+	}
+	if _, _, err := normalizeBulkTags([]string{"ACME"}, []string{"acme"}); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba rechazar asignar y quitar la misma etiqueta, obtenido %v", err)
+	}
+
+	uc := NewBulkProjectsUseCase(nil, nil, nil, services.DefaultProjectStatusWorkflow(), nil, nil)
+	for _, op := range []entities.BulkOperation{
+		{Action: "archive", ProjectIds: []int{1}},
+		{Action: entities.BulkActionReassign, ProjectIds: []int{1}},
+		{Action: entities.BulkActionStatus, ProjectIds: []int{1}, Status: "cancelled"},
+	} {
+		if _, err := uc.Execute(op, 7, false); !errors.Is(err, entities.ErrInvalidInput) {
+			t.Errorf("se esperaba ErrInvalidInput para %+v, obtenido %v", op, err)
+		}
+	}
+}
+
+// ============================================================================
+// Importación desde CSV
+// ============================================================================
+
+func TestCSVImport(t *testing.T) {
+	data := []byte("\uFEFFNombre;Fecha de visita;Categoría;Latitud;Longitud\n" +
+		"Predio norte;15/11/2025;Topografía;19,4326;-99,1332\n" +
+		"\n" +
+		"Predio sur;2025-13-40;Topografía;;\n")
+	header, records, err := readCSVRecords(data, 0)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if len(header) != 5 || header[0] != "Nombre" || len(records) != 2 || records[0].line != 2 || records[1].line != 4 {
+		t.Fatalf("lectura inesperada: %q %+v", header, records)
+	}
+	if _, _, err := readCSVRecords([]byte("nombre,fecha\n"), 0); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba rechazar un CSV sin filas, obtenido %v", err)
+	}
+
+	if _, err := services.ResolveCSVColumns(header, nil); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba pedir el mapeo de fecha, obtenido %v", err)
+	}
+	if _, err := services.ResolveCSVColumns(header, map[string]string{"password": "Nombre"}); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba rechazar un campo desconocido, obtenido %v", err)
+	}
+	columns, err := services.ResolveCSVColumns(header, map[string]string{"fecha": "fecha de visita"})
+	if err != nil || columns["nombre"] != 0 || columns["fecha"] != 1 || columns["categoria"] != 2 || columns["lng"] != 4 {
+		t.Fatalf("columnas inesperadas: %v (%v)", columns, err)
+	}
+
+	loc, _ := time.LoadLocation("America/Mexico_City")
+	project, err := services.CSVRecordToProject(records[0].fields, columns, 3, loc)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if project.NombreProyecto != "Predio norte" || project.UserId != 3 || project.Lat != 19.4326 || project.Lng != -99.1332 ||
+		!project.Fecha.Equal(time.Date(2025, 11, 15, 6, 0, 0, 0, time.UTC)) {
+		t.Errorf("proyecto inesperado: %+v", project)
+	}
+	if _, err := services.CSVRecordToProject(records[1].fields, columns, 3, loc); err == nil {
+		t.Error("se esperaba rechazar una fecha inválida")
+	}
+
+	if services.DetectCSVDelimiter("nombre\tfecha\tcategoria") != '\t' || services.DetectCSVDelimiter("nombre,fecha") != ',' {
+		t.Error("separador detectado inesperado")
+	}
+
+	jobs := services.NewImportJobService(1, 1, time.Hour)
+	defer jobs.Shutdown()
+	job, err := jobs.SubmitImportJob(2, true, func(progress func(int)) (*entities.ImportReport, error) {
+		progress(1)
+		return &entities.ImportReport{Total: 2, Valid: 2, DryRun: true}, nil
+	})
+	if err != nil || job.Status != entities.ImportJobQueued {
+		t.Fatalf("trabajo inesperado: %+v (%v)", job, err)
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for job.Status != entities.ImportJobCompleted && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		job, _ = jobs.GetImportJob(job.Id)
+	}
+	if job.Status != entities.ImportJobCompleted || job.Processed != 2 || job.Report == nil || job.Report.Valid != 2 {
+		t.Errorf("el trabajo no terminó como se esperaba: %+v", job)
+	}
+	if _, err := jobs.GetImportJob("desconocido"); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("se esperaba ErrNotFound, obtenido %v", err)
+	}
+}
+
+func TestImportProjectsCSV_DryRunReport(t *testing.T) {
+	data := []byte("nombre,fecha,categoria,lat,lng,user_id\n" +
+		"Predio norte,2025-11-15,Topografía,19.4326,-99.1332,\n" +
+		"Predio sur,2025-11-15,Hidrología,19.4326,-99.1332,\n" +
+		"Predio este,2025-13-40,Topografía,19.4326,-99.1332,\n" +
+		"Predio oeste,2025-11-15,Topografía,200,-99.1332,\n" +
+		"Ajeno,2025-11-15,Topografía,19.4326,-99.1332,9\n")
+
+	repo := newFakeProjectRepo()
+	uc := NewImportProjectsCSVUseCase(repo, newFakeCategoryRepo(), nil, nil)
+	if _, _, err := uc.Execute(data, entities.CSVImportOptions{DryRun: true, Location: time.UTC}); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba exigir un usuario autenticado, obtenido %v", err)
+	}
+
+	report, job, err := uc.Execute(data, entities.CSVImportOptions{DryRun: true, UserId: 7, Location: time.UTC})
+	if err != nil || job != nil {
+		t.Fatalf("se esperaba un reporte síncrono: %+v (%v)", job, err)
+	}
+	if !report.DryRun || report.Total != 5 || report.Valid != 1 || report.Failed != 4 || report.Created != 0 {
+		t.Errorf("conteos inesperados: %+v", report)
+	}
+	// index es la línea del archivo; cada fila inválida explica su error
+	expected := []struct {
+		line    int
+		success bool
+		error   string
+	}{
+		{2, true, ""},
+		{3, false, "categor"},
+		{4, false, "fecha"},
+		{5, false, "latitud"},
+		{6, false, "administrador"},
+	}
+	for i, want := range expected {
+		got := report.Results[i]
+		if got.Index != want.line || got.Success != want.success || !strings.Contains(strings.ToLower(got.Error), want.error) || got.ProjectId != 0 {
+			t.Errorf("fila %d: resultado inesperado %+v", want.line, got)
+		}
+	}
+	if repo.saves != 0 || len(repo.projects) != 0 {
+		t.Errorf("dryRun no debería guardar proyectos: %d llamadas a Save", repo.saves)
+	}
+
+	report, _, err = uc.Execute(data, entities.CSVImportOptions{UserId: 7, IsAdmin: true, Location: time.UTC})
+	if err != nil || report.Created != 2 || repo.saves != 2 {
+		t.Fatalf("importación inesperada: %+v (%v)", report, err)
+	}
+	if repo.projects[report.Results[0].ProjectId].UserId != 7 || repo.projects[report.Results[4].ProjectId].UserId != 9 {
+		t.Errorf("dueños inesperados: %+v", report.Results)
+	}
+}
+
+func TestImportJobService_Shutdown(t *testing.T) {
+	jobs := services.NewImportJobService(1, 1, time.Hour)
+	started, release := make(chan struct{}), make(chan struct{})
+	running, err := jobs.SubmitImportJob(1, false, func(progress func(int)) (*entities.ImportReport, error) {
+		close(started)
+		<-release
+		return &entities.ImportReport{Total: 1, Created: 1}, nil
+	})
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	<-started
+	queued, err := jobs.SubmitImportJob(1, false, func(progress func(int)) (*entities.ImportReport, error) {
+		t.Error("un trabajo en cola no debería iniciar durante el shutdown")
+		return &entities.ImportReport{}, nil
+	})
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		jobs.Shutdown()
+		close(done)
+	}()
+	// La cola está llena, así que ningún intento se encola; cuando el rechazo es por shutdown, los
+	// workers ya no inician trabajos y se libera el que está en curso
+	for {
+		if _, err := jobs.SubmitImportJob(1, false, nil); err != nil && strings.Contains(err.Error(), "shutdown") {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	<-done
+
+	if job, _ := jobs.GetImportJob(running.Id); job.Status != entities.ImportJobCompleted {
+		t.Errorf("el trabajo en curso debería terminar: %+v", job)
+	}
+	if job, _ := jobs.GetImportJob(queued.Id); job.Status != entities.ImportJobCanceled || job.FinishedAt == nil {
+		t.Errorf("el trabajo en cola debería quedar cancelado: %+v", job)
+	}
+}
+
+// ============================================================================
+// Reporte PDF
+// ============================================================================
+
+func TestProjectReportPDF(t *testing.T) {
+	var photo bytes.Buffer
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.RGBA{200, 30, 30, 255}), image.Point{}, draw.Src)
+	if err := png.Encode(&photo, img); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if pdfImage, err := services.NewPDFImage(photo.Bytes()); err != nil || pdfImage.Width != 40 || pdfImage.Height != 20 {
+		t.Fatalf("imagen inesperada: %+v (%v)", pdfImage, err)
+	}
+
+	// Un PNG de pocos bytes cuyo encabezado declara 100000x100000 píxeles se rechaza sin decodificarlo
+	bomb := bytes.Clone(photo.Bytes())
+	binary.BigEndian.PutUint32(bomb[16:], 100000)
+	binary.BigEndian.PutUint32(bomb[20:], 100000)
+	binary.BigEndian.PutUint32(bomb[29:], crc32.ChecksumIEEE(bomb[12:29]))
+	if _, err := services.NewPDFImage(bomb); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba rechazar la imagen por sus dimensiones, obtenido %v", err)
+	}
+
+	elevation := 2240.5
+	measurements := make([]entities.Measurement, 120)
+	for i := range measurements {
+		measurements[i] = entities.Measurement{Id: i + 1, Lat: 19.4326 + float64(i)*0.00001, Lng: -99.1332, Elevation: &elevation, Instrument: "GNSS", Notes: "Mojonera (esquina)"}
+	}
+	report := entities.ProjectReport{
+		Project: entities.Project{
+			Id: 7, NombreProyecto: "Predio Ñandú", Categoria: "Topografía", Status: entities.StatusDelivered,
+			Descripcion: strings.Repeat("Levantamiento de linderos y curvas de nivel. ", 30),
+			Lat:         19.4326, Lng: -99.1332, Fecha: time.Date(2025, 11, 15, 0, 0, 0, 0, time.UTC),
+			Geometry: &entities.ProjectGeometry{Type: entities.GeometryPolygon, Coordinates: [][]entities.Position{
+				{{-99.1335, 19.4324}, {-99.1329, 19.4324}, {-99.1329, 19.4329}, {-99.1335, 19.4324}},
+			}},
+			Checklist: []entities.ChecklistItem{{Text: "Entregar planos", Done: true}},
+		},
+		Tags: []entities.Tag{{Nombre: "Cliente ACME"}},
+		Images: []entities.ReportImage{
+			{Media: entities.ProjectMedia{Caption: "Vista norte"}, Data: photo.Bytes()},
+			{Media: entities.ProjectMedia{Caption: "Sin descarga"}},
+		},
+		Measurements: measurements,
+		Language:     "es",
+		GeneratedAt:  time.Now(),
+	}
+
+	var out bytes.Buffer
+	if err := services.RenderProjectReport(&out, report); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	pdf := out.String()
+	if !strings.HasPrefix(pdf, "%PDF-1.4") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Fatal("el documento no tiene el encabezado o el final de un PDF")
+	}
+
+	// Cada entrada de la tabla xref debe apuntar al inicio de su objeto
+	start := strings.LastIndex(pdf, "startxref\n")
+	xref, _ := strconv.Atoi(strings.TrimSpace(strings.TrimSuffix(pdf[start+len("startxref\n"):], "%%EOF\n")))
+	if !strings.HasPrefix(pdf[xref:], "xref\n") {
+		t.Fatalf("startxref apunta a %d, que no es la tabla xref", xref)
+	}
+	lines := strings.Split(pdf[xref:], "\n")
+	count, _ := strconv.Atoi(strings.Fields(lines[1])[1])
+	for id := 1; id < count; id++ {
+		offset, _ := strconv.Atoi(strings.Fields(lines[2+id])[0])
+		if !strings.HasPrefix(pdf[offset:], strconv.Itoa(id)+" 0 obj") {
+			t.Errorf("la entrada xref del objeto %d no apunta al objeto", id)
+		}
+	}
+
+	// El texto de las páginas se guarda comprimido
+	pages := strings.Count(pdf, "/Type /Page ")
+	if pages < 3 || !strings.Contains(pdf, fmt.Sprintf("/Count %d", pages)) || strings.Count(pdf, "/Subtype /Image") != 1 {
+		t.Errorf("estructura inesperada: %d páginas", pages)
+	}
+	var text strings.Builder
+	for _, part := range strings.Split(pdf, "/Filter /FlateDecode")[1:] {
+		begin := strings.Index(part, "stream\n") + len("stream\n")
+		zr, err := zlib.NewReader(strings.NewReader(part[begin:]))
+		if err != nil {
+			t.Fatalf("contenido de página inválido: %v", err)
+		}
+		data, _ := io.ReadAll(zr)
+		text.Write(data)
+	}
+	for _, fragment := range []string{"(Predio \\321and\\372)", "(Topograf\\355a)", "(Entregado)", "(Mojonera \\(esquina\\))", "(Imagen no disponible)", fmt.Sprintf("(P\\341gina %d de %d)", pages, pages)} {
+		if !strings.Contains(text.String(), fragment) {
+			t.Errorf("el reporte no contiene %s", fragment)
+		}
+	}
+}
+
+// ============================================================================
+// Estadísticas
+// ============================================================================
+
+func TestProjectStatsAggregation(t *testing.T) {
+	if _, err := services.ParseStatsGranularity("year"); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("se esperaba rechazar la granularidad year, obtenido %v", err)
+	}
+
+	loc, _ := time.LoadLocation("America/Mexico_City")
+	now := time.Date(2025, 11, 19, 15, 0, 0, 0, loc) // miércoles
+	defaults, err := services.AlignStatsRange(entities.DateRange{}, entities.StatsGranularityDay, now, loc)
+	if err != nil || !defaults.From.Equal(time.Date(2025, 11, 13, 0, 0, 0, 0, loc)) || !defaults.To.Equal(time.Date(2025, 11, 20, 0, 0, 0, 0, loc)) {
+		t.Errorf("rango por defecto inesperado: %v (%v)", defaults, err)
+	}
+	weeks, err := services.AlignStatsRange(entities.DateRange{From: now.AddDate(0, 0, -14)}, entities.StatsGranularityWeek, now, loc)
+	if err != nil || !weeks.From.Equal(time.Date(2025, 11, 3, 0, 0, 0, 0, loc)) || !weeks.To.Equal(time.Date(2025, 11, 24, 0, 0, 0, 0, loc)) {
+		t.Errorf("rango semanal inesperado: %v (%v)", weeks, err)
+	}
+	if _, err := services.AlignStatsRange(entities.DateRange{From: now.AddDate(-5, 0, 0)}, entities.StatsGranularityDay, now, loc); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("se esperaba rechazar más de 1000 días, obtenido %v", err)
+	}
+
+	months, err := services.AlignStatsRange(entities.DateRange{From: time.Date(2025, 2, 10, 0, 0, 0, 0, loc)}, entities.StatsGranularityMonth, now, loc)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	aggregator := services.NewProjectStatsAggregator(entities.ProjectStatsQuery{Granularity: entities.StatsGranularityMonth, Range: months, Location: loc})
+	previous := aggregator.PreviousRange()
+	if !previous.From.Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, loc)) || !previous.To.Equal(months.From) {
+		t.Errorf("rango anterior inesperado: %v", previous)
+	}
+
+	topografia := entities.ProjectStatsRecord{CategoryId: 1, Categoria: "Topografía", Status: entities.StatusDelivered, UserId: 3}
+	catastro := entities.ProjectStatsRecord{CategoryId: 2, Categoria: "Catastro", Status: entities.StatusDraft, UserId: 4}
+	for _, record := range []struct {
+		base  entities.ProjectStatsRecord
+		fecha time.Time
+	}{
+		{topografia, time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)}, // 28 de febrero en la Ciudad de México
+		{topografia, time.Date(2025, 11, 2, 12, 0, 0, 0, time.UTC)},
+		{catastro, time.Date(2025, 11, 5, 12, 0, 0, 0, time.UTC)},
+		{catastro, time.Date(2024, 12, 5, 12, 0, 0, 0, time.UTC)},
+		{catastro, time.Date(2024, 11, 5, 12, 0, 0, 0, time.UTC)},
+	} {
+		record.base.Fecha = record.fecha
+		aggregator.Add(record.base)
+	}
+
+	stats := aggregator.Result()
+	if stats.Scope != entities.StatsScopeOrganization || stats.From != "2025-02-01" || stats.To != "2025-11-30" || len(stats.Series) != 10 {
+		t.Fatalf("estadísticas inesperadas: %+v", stats)
+	}
+	if stats.Series[0].Period != "2025-02" || stats.Series[0].Count != 1 || stats.Series[1].Count != 0 || stats.Series[9].Count != 2 {
+		t.Errorf("serie inesperada: %+v", stats.Series)
+	}
+	comparison := stats.Comparison
+	if comparison.PreviousCount != 2 || comparison.Change != 1 || comparison.ChangePercent == nil || *comparison.ChangePercent != 50 || len(comparison.PreviousSeries) != 10 {
+		t.Errorf("comparación inesperada: %+v", comparison)
+	}
+	if len(stats.ByCategory) != 2 || stats.ByCategory[0].Categoria != "Topografía" || stats.ByCategory[0].Share != 66.67 || stats.ByCategory[1].PreviousCount != 2 {
+		t.Errorf("categorías inesperadas: %+v", stats.ByCategory)
+	}
+	if stats.ByStatus[entities.StatusDelivered] != 2 || stats.ByStatus[entities.StatusArchived] != 0 || len(stats.ByStatus) != len(entities.ProjectStatuses) {
+		t.Errorf("estados inesperados: %v", stats.ByStatus)
+	}
+	if stats.Organization == nil || stats.Organization.ActiveUsers != 2 || stats.Organization.AveragePerUser != 1.5 || stats.Organization.TopUsers[0].UserId != 3 {
+		t.Errorf("agregados de la organización inesperados: %+v", stats.Organization)
+	}
+}
+
+func TestGetProjectClusters_RequiresBBox(t *testing.T) {
+	uc := NewGetProjectClustersUseCase(nil)
+	if _, err := uc.Execute(entities.ProjectFilter{}, 5); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("se esperaba exigir el bbox, obtenido %v", err)
+	}
+}
@@ -0,0 +1,19 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type RemoveProjectTagUseCase struct {
+	tags repository.TagRepository
+}
+
+func NewRemoveProjectTagUseCase(tags repository.TagRepository) *RemoveProjectTagUseCase {
+	return &RemoveProjectTagUseCase{tags: tags}
+}
+
+// Execute quita una etiqueta del proyecto; acepta el slug o el nombre de la etiqueta
+func (uc *RemoveProjectTagUseCase) Execute(projectId int, tag string) error {
+	return uc.tags.RemoveFromProject(projectId, services.Slugify(tag))
+}
@@ -0,0 +1,35 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type ReorderProjectMediaUseCase struct {
+	db    repository.ProjectRepository
+	media repository.ProjectMediaRepository
+}
+
+func NewReorderProjectMediaUseCase(db repository.ProjectRepository, media repository.ProjectMediaRepository) *ReorderProjectMediaUseCase {
+	return &ReorderProjectMediaUseCase{db: db, media: media}
+}
+
+// Execute ordena la galería según mediaIds, que debe incluir todas sus imágenes
+func (uc *ReorderProjectMediaUseCase) Execute(projectId int, mediaIds []int) ([]entities.ProjectMedia, error) {
+	if _, err := uc.db.FindById(projectId); err != nil {
+		return nil, err
+	}
+	current, err := uc.media.FindByProject(projectId)
+	if err != nil {
+		return nil, err
+	}
+	if err := services.ValidateMediaOrder(current, mediaIds); err != nil {
+		return nil, err
+	}
+
+	if err := uc.media.Reorder(projectId, mediaIds); err != nil {
+		return nil, err
+	}
+	return uc.media.FindByProject(projectId)
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type RestoreProjectRevisionUseCase struct {
+	db         repository.ProjectRepository
+	revisions  repository.ProjectRevisionRepository
+	categories repository.CategoryRepository
+	geocodeSrv *services.GeocodingWorkerService
+}
+
+func NewRestoreProjectRevisionUseCase(db repository.ProjectRepository, revisions repository.ProjectRevisionRepository, categories repository.CategoryRepository, geocodeSrv *services.GeocodingWorkerService) *RestoreProjectRevisionUseCase {
+	return &RestoreProjectRevisionUseCase{db: db, revisions: revisions, categories: categories, geocodeSrv: geocodeSrv}
+}
+
+// Execute vuelve a aplicar los datos de una revisión anterior. La restauración no borra el historial:
+// se guarda como una revisión nueva que indica de cuál proviene. expectedVersion 0 acepta cualquier versión.
+// Solo el dueño o un administrador pueden restaurar, y el proyecto conserva su dueño actual
+func (uc *RestoreProjectRevisionUseCase) Execute(projectId, number, expectedVersion, editorId int, isAdmin bool) (*entities.Project, error) {
+	current, err := uc.db.FindById(projectId)
+	if err != nil {
+		return nil, err
+	}
+	if !services.CanModifyProject(*current, editorId, isAdmin) {
+		return nil, fmt.Errorf("%w: el proyecto pertenece a otro usuario", entities.ErrForbidden)
+	}
+	version, err := checkExpectedVersion(*current, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := uc.revisions.FindRevision(projectId, number)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := revision.Snapshot
+	restored.Id = projectId
+	restored.Version = version
+	restored.UserId = current.UserId
+	// Las revisiones anteriores al catálogo solo tienen el nombre de la categoría
+	if err := resolveProjectCategory(uc.categories, &restored); err != nil {
+		return nil, err
+	}
+	if err := applyProjectGeometry(&restored); err != nil {
+		return nil, err
+	}
+	if err := validateProjectFields(restored); err != nil {
+		return nil, err
+	}
+
+	change := entities.ProjectRevision{Action: entities.RevisionActionRestore, EditorId: editorId, RestoredFrom: number}
+	if err := uc.db.Update(restored, change); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.db.FindById(projectId)
+	if err != nil {
+		return nil, err
+	}
+	requestGeocoding(uc.geocodeSrv, current, *updated)
+
+	log.Printf("SUCCESS: Proyecto %d restaurado a la revisión %d", projectId, number)
+	return updated, nil
+}
@@ -0,0 +1,53 @@
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// descripcionSnippetWords es el tamaño del fragmento resaltado de la descripción
+const descripcionSnippetWords = 30
+
+type SearchProjectsUseCase struct {
+	searchRepo repository.ProjectSearchRepository
+}
+
+func NewSearchProjectsUseCase(searchRepo repository.ProjectSearchRepository) *SearchProjectsUseCase {
+	return &SearchProjectsUseCase{searchRepo: searchRepo}
+}
+
+func (uc *SearchProjectsUseCase) Execute(query entities.ProjectSearchQuery) ([]entities.ProjectSearchResult, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	if query.Text == "" {
+		return nil, fmt.Errorf("%w: el texto de búsqueda es obligatorio", entities.ErrInvalidFilter)
+	}
+
+	query.Terms = services.Tokenize(query.Text)
+	if len(query.Terms) == 0 {
+		return []entities.ProjectSearchResult{}, nil
+	}
+
+	query.Limit = clampLimit(query.Limit)
+
+	results, err := uc.searchRepo.Search(query)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		highlights := make(map[string]string)
+		if nombre, ok := services.HighlightTerms(results[i].Project.NombreProyecto, query.Terms, 0); ok {
+			highlights["nombre"] = nombre
+		}
+		if descripcion, ok := services.HighlightTerms(results[i].Project.Descripcion, query.Terms, descripcionSnippetWords); ok {
+			highlights["descripcion"] = descripcion
+		}
+		results[i].Highlights = highlights
+	}
+
+	return results, nil
+}
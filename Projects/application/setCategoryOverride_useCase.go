@@ -0,0 +1,26 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type SetCategoryOverrideUseCase struct {
+	categories repository.CategoryRepository
+}
+
+func NewSetCategoryOverrideUseCase(categories repository.CategoryRepository) *SetCategoryOverrideUseCase {
+	return &SetCategoryOverrideUseCase{categories: categories}
+}
+
+// Execute crea o reemplaza la personalización de una categoría para una organización
+func (uc *SetCategoryOverrideUseCase) Execute(override entities.CategoryOverride) error {
+	if _, err := uc.categories.FindById(override.CategoryId); err != nil {
+		return err
+	}
+	if err := services.ValidateCategoryOverride(&override); err != nil {
+		return err
+	}
+	return uc.categories.SaveOverride(override)
+}
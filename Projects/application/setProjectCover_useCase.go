@@ -0,0 +1,23 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+)
+
+type SetProjectCoverUseCase struct {
+	media repository.ProjectMediaRepository
+}
+
+func NewSetProjectCoverUseCase(media repository.ProjectMediaRepository) *SetProjectCoverUseCase {
+	return &SetProjectCoverUseCase{media: media}
+}
+
+// Execute marca la imagen como portada; su URL pasa a ser la Img del proyecto y el cambio queda en su
+// historial a nombre de editorId
+func (uc *SetProjectCoverUseCase) Execute(projectId, mediaId, editorId int) ([]entities.ProjectMedia, error) {
+	if err := uc.media.SetCover(projectId, mediaId, editorId); err != nil {
+		return nil, err
+	}
+	return uc.media.FindByProject(projectId)
+}
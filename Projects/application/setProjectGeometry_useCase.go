@@ -0,0 +1,51 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type SetProjectGeometryUseCase struct {
+	db         repository.ProjectRepository
+	geocodeSrv *services.GeocodingWorkerService
+}
+
+func NewSetProjectGeometryUseCase(db repository.ProjectRepository, geocodeSrv *services.GeocodingWorkerService) *SetProjectGeometryUseCase {
+	return &SetProjectGeometryUseCase{db: db, geocodeSrv: geocodeSrv}
+}
+
+// Execute reemplaza el área levantada del proyecto y recalcula área, perímetro y centroide.
+// geometry nil quita el área y conserva el último centroide como Lat/Lng. expectedVersion 0 acepta cualquier versión
+func (uc *SetProjectGeometryUseCase) Execute(id int, geometry *entities.ProjectGeometry, expectedVersion, editorId int) (*entities.Project, error) {
+	current, err := uc.db.FindById(id)
+	if err != nil {
+		return nil, err
+	}
+	version, err := checkExpectedVersion(*current, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	project := *current
+	project.Version = version
+	project.Geometry = geometry
+	if err := applyProjectGeometry(&project); err != nil {
+		return nil, err
+	}
+	if err := validateProjectFields(project); err != nil {
+		return nil, err
+	}
+
+	change := entities.ProjectRevision{Action: entities.RevisionActionUpdate, EditorId: editorId}
+	if err := uc.db.Update(project, change); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.db.FindById(id)
+	if err != nil {
+		return nil, err
+	}
+	requestGeocoding(uc.geocodeSrv, current, *updated)
+	return updated, nil
+}
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type TransitionProjectStatusUseCase struct {
+	db       repository.ProjectRepository
+	statuses repository.ProjectStatusRepository
+	workflow entities.ProjectStatusWorkflow
+}
+
+func NewTransitionProjectStatusUseCase(db repository.ProjectRepository, statuses repository.ProjectStatusRepository, workflow entities.ProjectStatusWorkflow) *TransitionProjectStatusUseCase {
+	return &TransitionProjectStatusUseCase{db: db, statuses: statuses, workflow: workflow}
+}
+
+// Execute pasa el proyecto al estado status si el flujo lo permite desde su estado actual y registra la
+// transición con el comentario. Una transición no permitida devuelve ErrConflict.
+// expectedVersion 0 acepta cualquier versión
+func (uc *TransitionProjectStatusUseCase) Execute(id int, status, comment string, expectedVersion, userId int) (*entities.Project, error) {
+	current, err := uc.db.FindById(id)
+	if err != nil {
+		return nil, err
+	}
+	version, err := checkExpectedVersion(*current, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	status = strings.ToLower(strings.TrimSpace(status))
+	comment = strings.TrimSpace(comment)
+	if utf8.RuneCountInString(comment) > services.MaxStatusCommentLength {
+		return nil, fmt.Errorf("%w: el comentario admite como máximo %d caracteres", entities.ErrInvalidInput, services.MaxStatusCommentLength)
+	}
+	if err := services.CheckStatusTransition(uc.workflow, current.Status, status); err != nil {
+		return nil, err
+	}
+
+	transition := entities.ProjectStatusTransition{
+		ProjectId:  id,
+		FromStatus: current.Status,
+		ToStatus:   status,
+		Comment:    comment,
+		UserId:     userId,
+	}
+	if err := uc.statuses.ChangeStatus(transition, version); err != nil {
+		return nil, err
+	}
+
+	log.Printf("INFO: Proyecto %d pasó de %s a %s", id, current.Status, status)
+	return uc.db.FindById(id)
+}
@@ -0,0 +1,33 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type UpdateCategoryUseCase struct {
+	categories repository.CategoryRepository
+}
+
+func NewUpdateCategoryUseCase(categories repository.CategoryRepository) *UpdateCategoryUseCase {
+	return &UpdateCategoryUseCase{categories: categories}
+}
+
+// Execute reemplaza los datos de la categoría; los proyectos que la usan reciben el nombre nuevo
+func (uc *UpdateCategoryUseCase) Execute(category entities.Category) (*entities.Category, error) {
+	if _, err := uc.categories.FindById(category.Id); err != nil {
+		return nil, err
+	}
+	if err := services.ValidateCategory(&category); err != nil {
+		return nil, err
+	}
+	if err := ensureSlugAvailable(uc.categories, category.Slug, category.Id); err != nil {
+		return nil, err
+	}
+
+	if err := uc.categories.Update(category); err != nil {
+		return nil, err
+	}
+	return uc.categories.FindById(category.Id)
+}
@@ -0,0 +1,26 @@
+package application
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type UpdateMeasurementUseCase struct {
+	measurements repository.MeasurementRepository
+}
+
+func NewUpdateMeasurementUseCase(measurements repository.MeasurementRepository) *UpdateMeasurementUseCase {
+	return &UpdateMeasurementUseCase{measurements: measurements}
+}
+
+// Execute reemplaza los datos de un punto del proyecto
+func (uc *UpdateMeasurementUseCase) Execute(measurement entities.Measurement) (*entities.Measurement, error) {
+	if err := services.ValidateMeasurement(&measurement); err != nil {
+		return nil, err
+	}
+	if err := uc.measurements.Update(measurement); err != nil {
+		return nil, err
+	}
+	return uc.measurements.FindById(measurement.ProjectId, measurement.Id)
+}
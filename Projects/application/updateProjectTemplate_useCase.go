@@ -0,0 +1,42 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type UpdateProjectTemplateUseCase struct {
+	templates  repository.ProjectTemplateRepository
+	categories repository.CategoryRepository
+}
+
+func NewUpdateProjectTemplateUseCase(templates repository.ProjectTemplateRepository, categories repository.CategoryRepository) *UpdateProjectTemplateUseCase {
+	return &UpdateProjectTemplateUseCase{templates: templates, categories: categories}
+}
+
+// Execute reemplaza los datos de la plantilla si editorId es su autor o un administrador; el autor no
+// cambia. Los proyectos ya creados con ella no cambian
+func (uc *UpdateProjectTemplateUseCase) Execute(template entities.ProjectTemplate, editorId int, isAdmin bool) (*entities.ProjectTemplate, error) {
+	current, err := uc.templates.FindById(template.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !services.CanModifyTemplate(*current, editorId, isAdmin) {
+		return nil, fmt.Errorf("%w: la plantilla pertenece a otro usuario", entities.ErrForbidden)
+	}
+	template.UserId = current.UserId
+	if err := services.ValidateProjectTemplate(&template); err != nil {
+		return nil, err
+	}
+	if err := resolveTemplateCategory(uc.categories, &template); err != nil {
+		return nil, err
+	}
+
+	if err := uc.templates.Update(template); err != nil {
+		return nil, err
+	}
+	return uc.templates.FindById(template.Id)
+}
@@ -1,7 +1,7 @@
 package application
 
 import (
-	"sync"
+	"fmt"
 	"time"
 
 	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
@@ -10,33 +10,104 @@ import (
 )
 
 type UpdateProjectUseCase struct {
-	repo      repository.ProjectRepository
-	cloudSrv  services.ICloudinaryService
-	workerSrv *services.ImageUploadWorkerService
-	mu        sync.Mutex // Protege acceso a repo.Update
+	repo       repository.ProjectRepository
+	categories repository.CategoryRepository
+	media      repository.ProjectMediaRepository
+	cloudSrv   services.ICloudinaryService
+	workerSrv  *services.ImageUploadWorkerService
+	geocodeSrv *services.GeocodingWorkerService
 }
 
-func NewUpdateProjectUseCase(repo repository.ProjectRepository, cloudSrv services.ICloudinaryService, workerSrv *services.ImageUploadWorkerService) *UpdateProjectUseCase {
+func NewUpdateProjectUseCase(repo repository.ProjectRepository, categories repository.CategoryRepository, media repository.ProjectMediaRepository, cloudSrv services.ICloudinaryService, workerSrv *services.ImageUploadWorkerService, geocodeSrv *services.GeocodingWorkerService) *UpdateProjectUseCase {
 	return &UpdateProjectUseCase{
-		repo:      repo,
-		cloudSrv:  cloudSrv,
-		workerSrv: workerSrv,
+		repo:       repo,
+		categories: categories,
+		media:      media,
+		cloudSrv:   cloudSrv,
+		workerSrv:  workerSrv,
+		geocodeSrv: geocodeSrv,
 	}
 }
 
-func (uc *UpdateProjectUseCase) Execute(project entities.Project, imagePath string) error {
+// Execute reemplaza los datos del proyecto y guarda una revisión con los campos modificados.
+// project.Version es la versión que conoce el cliente: si el proyecto cambió entretanto se devuelve
+// ErrVersionConflict. Si no se envía una imagen nueva se conserva la actual; una imagen nueva pasa a ser
+// la portada de la galería y las imágenes de media se agregan al final; lo mismo ocurre con la geometría. editorId es el usuario
+// que hace el cambio; solo el dueño o un administrador pueden actualizar el proyecto y el dueño se conserva. Si el cambio se rechaza después de subir las imágenes (por
+// ejemplo, otro editor guardó antes) las imágenes subidas se eliminan. Devuelve el proyecto ya actualizado
+func (uc *UpdateProjectUseCase) Execute(project entities.Project, imagePath string, media []entities.MediaUpload, editorId int, isAdmin bool) (*entities.Project, error) {
+	current, err := uc.repo.FindById(project.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !services.CanModifyProject(*current, editorId, isAdmin) {
+		return nil, fmt.Errorf("%w: el proyecto pertenece a otro usuario", entities.ErrForbidden)
+	}
+	// El dueño solo cambia con la reasignación masiva
+	project.UserId = current.UserId
+	// Se valida antes de subir la imagen para no subirla si el cambio se va a rechazar
+	if project.Version, err = checkExpectedVersion(*current, project.Version); err != nil {
+		return nil, err
+	}
+	if err := resolveProjectCategory(uc.categories, &project); err != nil {
+		return nil, err
+	}
+	// Sin geometría nueva se conserva la actual, y con ella el centroide como Lat/Lng
+	if project.Geometry == nil {
+		project.Geometry = current.Geometry
+	}
+	// La lista de verificación se modifica con PATCH; el formulario de actualización no la incluye
+	if project.Checklist == nil {
+		project.Checklist = current.Checklist
+	}
+	if err := applyProjectGeometry(&project); err != nil {
+		return nil, err
+	}
+	if err := validateProjectFields(project); err != nil {
+		return nil, err
+	}
+	if err := services.ValidateMediaUploads(media); err != nil {
+		return nil, err
+	}
+
 	if imagePath != "" {
 		// Usar el worker service con timeout de 30 segundos
 		url, err := uc.workerSrv.SubmitUploadJobSync(imagePath, 30*time.Second)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		project.Img = url
+	} else {
+		project.Img = current.Img
 	}
 
-	// Proteger acceso concurrente al repositorio
-	uc.mu.Lock()
-	defer uc.mu.Unlock()
+	gallery, err := uploadProjectMedia(uc.workerSrv, media)
+	if imagePath != "" {
+		gallery = append([]entities.ProjectMedia{{Url: project.Img, IsCover: true}}, gallery...)
+	}
+	if err != nil {
+		discardUploads(uc.workerSrv, gallery)
+		return nil, err
+	}
+
+	// La versión condiciona el UPDATE en la base de datos, por lo que dos editores en procesos
+	// distintos no pueden sobrescribirse entre sí
+	change := entities.ProjectRevision{Action: entities.RevisionActionUpdate, EditorId: editorId}
+	if err := uc.repo.Update(project, change); err != nil {
+		discardUploads(uc.workerSrv, gallery)
+		return nil, err
+	}
 
-	return uc.repo.Update(project)
+	if len(gallery) > 0 {
+		if err := uc.media.Add(project.Id, gallery, editorId); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := uc.repo.FindById(project.Id)
+	if err != nil {
+		return nil, err
+	}
+	requestGeocoding(uc.geocodeSrv, current, *updated)
+	return updated, nil
 }
@@ -0,0 +1,104 @@
+package application
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type UploadProjectDocumentUseCase struct {
+	db        repository.ProjectRepository
+	documents repository.ProjectDocumentRepository
+	storage   services.IDocumentStorage
+	maxSize   int64
+}
+
+func NewUploadProjectDocumentUseCase(db repository.ProjectRepository, documents repository.ProjectDocumentRepository, storage services.IDocumentStorage, maxSize int64) *UploadProjectDocumentUseCase {
+	if maxSize <= 0 {
+		maxSize = services.DefaultMaxDocumentSize
+	}
+	return &UploadProjectDocumentUseCase{db: db, documents: documents, storage: storage, maxSize: maxSize}
+}
+
+// MaxSize es el tamaño máximo aceptado para un adjunto
+func (uc *UploadProjectDocumentUseCase) MaxSize() int64 {
+	return uc.maxSize
+}
+
+// Execute valida el adjunto por extensión, contenido y tamaño, calcula su SHA-256 y lo guarda en el
+// almacenamiento. Un archivo idéntico ya adjunto al mismo proyecto devuelve ErrConflict
+func (uc *UploadProjectDocumentUseCase) Execute(projectId int, fileName string, size int64, content io.ReadSeeker, uploadedBy int) (*entities.ProjectDocument, error) {
+	fileName = services.SafeFileName(fileName)
+	head := make([]byte, 512)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error al leer el documento: %w", err)
+	}
+	contentType, err := services.ValidateDocument(fileName, size, head[:n], uc.maxSize)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.db.FindById(projectId); err != nil {
+		return nil, err
+	}
+
+	checksum, err := sha256Hex(content)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := uc.documents.FindByChecksum(projectId, checksum)
+	if err == nil {
+		return nil, fmt.Errorf("%w: el archivo ya está adjunto al proyecto como %q (documento %d)", entities.ErrConflict, existing.FileName, existing.Id)
+	}
+	if !errors.Is(err, entities.ErrNotFound) {
+		return nil, err
+	}
+
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("error al leer el documento: %w", err)
+	}
+	key := fmt.Sprintf("projects/%d/%s%s", projectId, checksum, strings.ToLower(filepath.Ext(fileName)))
+	location, err := uc.storage.Save(key, content, size, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	document := entities.ProjectDocument{
+		ProjectId:   projectId,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		Checksum:    checksum,
+		Location:    location,
+		UploadedBy:  uploadedBy,
+	}
+	id, err := uc.documents.Save(document)
+	if err != nil {
+		if delErr := uc.storage.Delete(location); delErr != nil {
+			log.Printf("WARNING: No se pudo eliminar el documento huérfano %s: %v", location, delErr)
+		}
+		return nil, err
+	}
+	return uc.documents.FindById(projectId, id)
+}
+
+// sha256Hex calcula el SHA-256 del contenido completo desde el inicio
+func sha256Hex(content io.ReadSeeker) (string, error) {
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("error al leer el documento: %w", err)
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, content); err != nil {
+		return "", fmt.Errorf("error al calcular el checksum: %w", err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
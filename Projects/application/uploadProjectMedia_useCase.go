@@ -0,0 +1,43 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+type UploadProjectMediaUseCase struct {
+	db        repository.ProjectRepository
+	media     repository.ProjectMediaRepository
+	workerSrv *services.ImageUploadWorkerService
+}
+
+func NewUploadProjectMediaUseCase(db repository.ProjectRepository, media repository.ProjectMediaRepository, workerSrv *services.ImageUploadWorkerService) *UploadProjectMediaUseCase {
+	return &UploadProjectMediaUseCase{db: db, media: media, workerSrv: workerSrv}
+}
+
+// Execute sube las imágenes y las agrega al final de la galería; devuelve la galería completa. Si la
+// galería no tenía portada, el cambio de imagen del proyecto queda en su historial a nombre de editorId
+func (uc *UploadProjectMediaUseCase) Execute(projectId int, uploads []entities.MediaUpload, editorId int) ([]entities.ProjectMedia, error) {
+	if len(uploads) == 0 {
+		return nil, fmt.Errorf("%w: se requiere al menos una imagen", entities.ErrInvalidInput)
+	}
+	if err := services.ValidateMediaUploads(uploads); err != nil {
+		return nil, err
+	}
+	if _, err := uc.db.FindById(projectId); err != nil {
+		return nil, err
+	}
+
+	media, err := uploadProjectMedia(uc.workerSrv, uploads)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.media.Add(projectId, media, editorId); err != nil {
+		discardUploads(uc.workerSrv, media)
+		return nil, err
+	}
+	return uc.media.FindByProject(projectId)
+}
@@ -0,0 +1,27 @@
+package entities
+
+import "time"
+
+// Category es una categoría del catálogo administrado. Slug es el identificador estable que se usa
+// en las URLs y al clasificar proyectos
+type Category struct {
+	Id         int       `json:"id"`
+	Slug       string    `json:"slug"`
+	Nombre     string    `json:"nombre"`
+	Color      string    `json:"color"`
+	Icon       string    `json:"icon"`
+	Overridden bool      `json:"overridden,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// CategoryOverride personaliza una categoría para una organización. Los campos vacíos conservan
+// el valor global y Hidden la oculta del catálogo de esa organización
+type CategoryOverride struct {
+	CategoryId int    `json:"category_id"`
+	OrgId      int    `json:"org_id"`
+	Nombre     string `json:"nombre,omitempty"`
+	Color      string `json:"color,omitempty"`
+	Icon       string `json:"icon,omitempty"`
+	Hidden     bool   `json:"hidden"`
+}
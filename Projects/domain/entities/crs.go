@@ -0,0 +1,20 @@
+package entities
+
+// CRSWGS84 es el código EPSG del sistema en que se guardan Lat/Lng y la geometría
+const CRSWGS84 = 4326
+
+// GeoJSONCRS es el miembro crs con nombre de GeoJSON 2008, usado cuando las coordenadas no están en WGS84
+type GeoJSONCRS struct {
+	Type       string            `json:"type"`
+	Properties map[string]string `json:"properties"`
+}
+
+// ProjectInCRS es un proyecto con su ubicación y su geometría expresadas además en otro sistema de
+// referencia; Lat/Lng y Geometry se mantienen en WGS84
+type ProjectInCRS struct {
+	Project
+	CRS               string
+	X                 float64
+	Y                 float64
+	ProjectedGeometry *ProjectGeometry `json:",omitempty"`
+}
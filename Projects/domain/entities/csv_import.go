@@ -0,0 +1,41 @@
+package entities
+
+import "time"
+
+// CSVImportOptions indica cómo importar un CSV de proyectos. Mapping relaciona cada campo del proyecto
+// (nombre, fecha, categoria...) con el encabezado de la columna que lo contiene; los campos que no
+// aparecen se buscan por su nombre. Delimiter 0 detecta el separador a partir del encabezado.
+// UserId es el usuario autenticado, dueño de los proyectos; con IsAdmin la columna user_id asigna otro dueño
+type CSVImportOptions struct {
+	Mapping   map[string]string
+	DryRun    bool
+	Async     bool
+	UserId    int
+	IsAdmin   bool
+	Location  *time.Location
+	Delimiter rune
+}
+
+// Estados de un trabajo de importación en segundo plano
+const (
+	ImportJobQueued    = "queued"
+	ImportJobRunning   = "running"
+	ImportJobCompleted = "completed"
+	ImportJobFailed    = "failed"
+	// ImportJobCanceled es un trabajo que seguía en la cola cuando el servidor se detuvo
+	ImportJobCanceled = "canceled"
+)
+
+// ImportJob es una importación que se procesa en segundo plano; Processed permite mostrar el avance
+// y Report queda disponible al terminar
+type ImportJob struct {
+	Id         string        `json:"id"`
+	Status     string        `json:"status"`
+	DryRun     bool          `json:"dry_run"`
+	Total      int           `json:"total"`
+	Processed  int           `json:"processed"`
+	Report     *ImportReport `json:"report,omitempty"`
+	Error      string        `json:"error,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+	FinishedAt *time.Time    `json:"finished_at,omitempty"`
+}
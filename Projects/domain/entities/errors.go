@@ -0,0 +1,20 @@
+package entities
+
+import "errors"
+
+var (
+	// ErrInvalidFilter se devuelve cuando los parámetros de un listado no son válidos
+	ErrInvalidFilter = errors.New("filtro inválido")
+	// ErrInvalidInput se devuelve cuando los datos enviados para crear o modificar no son válidos
+	ErrInvalidInput = errors.New("datos inválidos")
+	// ErrNotFound se devuelve cuando el recurso solicitado no existe
+	ErrNotFound = errors.New("no encontrado")
+	// ErrVersionConflict se devuelve cuando el recurso cambió desde la versión que conoce el cliente
+	ErrVersionConflict = errors.New("conflicto de versión")
+	// ErrConflict se devuelve cuando la operación choca con el estado actual, como un slug repetido
+	ErrConflict = errors.New("conflicto")
+	// ErrForbidden se devuelve cuando el usuario no puede modificar el recurso, como un proyecto ajeno
+	ErrForbidden = errors.New("sin permiso")
+	// ErrUnavailable se devuelve cuando un servicio externo necesario no está configurado o no responde
+	ErrUnavailable = errors.New("servicio no disponible")
+)
@@ -0,0 +1,42 @@
+package entities
+
+import "encoding/json"
+
+// GeoJSONGeometry es una geometría GeoJSON; las coordenadas se conservan sin decodificar
+// porque su forma depende del tipo
+type GeoJSONGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+type GeoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Id         interface{}            `json:"id,omitempty"`
+	Geometry   *GeoJSONGeometry       `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	CRS      *GeoJSONCRS      `json:"crs,omitempty"`
+	Features []GeoJSONFeature `json:"features"`
+}
+
+// ImportItemResult es el resultado de importar un elemento (feature o fila)
+type ImportItemResult struct {
+	Index     int    `json:"index"`
+	Success   bool   `json:"success"`
+	ProjectId int    `json:"project_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ImportReport resume una importación masiva de proyectos. En una validación sin guardar (DryRun)
+// Valid cuenta los elementos que se crearían
+type ImportReport struct {
+	Total   int                `json:"total"`
+	Created int                `json:"created"`
+	Failed  int                `json:"failed"`
+	DryRun  bool               `json:"dry_run,omitempty"`
+	Valid   int                `json:"valid,omitempty"`
+	Results []ImportItemResult `json:"results"`
+}
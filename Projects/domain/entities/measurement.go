@@ -0,0 +1,26 @@
+package entities
+
+import "time"
+
+// Measurement es un punto levantado en campo dentro de un proyecto. Elevation está en metros sobre
+// el nivel del mar y Accuracy es la precisión horizontal en metros informada por el instrumento
+type Measurement struct {
+	Id         int       `json:"id"`
+	ProjectId  int       `json:"project_id"`
+	Lat        float64   `json:"lat"`
+	Lng        float64   `json:"lng"`
+	Elevation  *float64  `json:"elevation,omitempty"`
+	MeasuredAt time.Time `json:"measured_at"`
+	Instrument string    `json:"instrument"`
+	Accuracy   *float64  `json:"accuracy,omitempty"`
+	Notes      string    `json:"notes"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// MeasurementPage es una página de puntos; NextAfter es el valor de after para la página siguiente
+type MeasurementPage struct {
+	Measurements []Measurement `json:"data"`
+	Limit        int           `json:"limit"`
+	NextAfter    int           `json:"next_after,omitempty"`
+}
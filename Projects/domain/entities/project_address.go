@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+// ProjectAddress es la división administrativa en la que cae la ubicación de un proyecto, obtenida
+// por geocodificación inversa. Los campos vacíos indican que el geocodificador no los conoce
+type ProjectAddress struct {
+	Municipality string    `json:"municipality"`
+	State        string    `json:"state"`
+	Country      string    `json:"country"`
+	CountryCode  string    `json:"country_code"`
+	GeocodedAt   time.Time `json:"geocoded_at"`
+}
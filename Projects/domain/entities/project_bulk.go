@@ -0,0 +1,48 @@
+package entities
+
+// Acciones de las operaciones masivas sobre proyectos
+const (
+	BulkActionDelete       = "delete"
+	BulkActionRecategorize = "recategorize"
+	BulkActionRetag        = "retag"
+	BulkActionReassign     = "reassign"
+	BulkActionStatus       = "status"
+)
+
+// BulkOperation es una operación sobre varios proyectos. Según Action se usan CategoryId o Categoria
+// (recategorize), AddTags y RemoveTags (retag), UserId (reassign) o Status y Comment (status)
+type BulkOperation struct {
+	Action     string   `json:"action"`
+	ProjectIds []int    `json:"ids"`
+	CategoryId int      `json:"categoryId"`
+	Categoria  string   `json:"categoria"`
+	AddTags    []string `json:"addTags"`
+	RemoveTags []string `json:"removeTags"`
+	UserId     int      `json:"userId"`
+	Status     string   `json:"status"`
+	Comment    string   `json:"comment"`
+}
+
+// BulkTarget identifica un proyecto de la operación y la versión con la que se validó
+type BulkTarget struct {
+	Id      int
+	Version int
+}
+
+// BulkItemResult es el resultado de la operación para un proyecto
+type BulkItemResult struct {
+	ProjectId int    `json:"project_id"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+// BulkReport resume una operación masiva. Se aplica a todos los proyectos o a ninguno: con Applied en
+// false ningún proyecto cambió y Results indica cuáles impidieron la operación
+type BulkReport struct {
+	Action    string           `json:"action"`
+	Total     int              `json:"total"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Applied   bool             `json:"applied"`
+	Results   []BulkItemResult `json:"results"`
+}
@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateLayout es el formato ISO-8601 de una fecha sin hora
+const DateLayout = "2006-01-02"
+
+// projectDateLayouts son los formatos ISO-8601 aceptados para la fecha de un proyecto
+var projectDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	DateLayout,
+}
+
+// LoadTimezone obtiene la zona horaria IANA indicada por el cliente; vacía equivale a UTC
+func LoadTimezone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("zona horaria desconocida %q", name)
+	}
+	return loc, nil
+}
+
+// ParseProjectDate interpreta una fecha ISO-8601. Las fechas sin zona horaria se consideran
+// en loc; el resultado siempre se devuelve en UTC
+func ParseProjectDate(value string, loc *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("la fecha es obligatoria")
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	for _, layout := range projectDateLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("la fecha %q no tiene un formato ISO-8601 válido (AAAA-MM-DD o AAAA-MM-DDTHH:MM:SS±HH:MM)", value)
+}
+
+// StartOfDay devuelve el inicio del día de t en la zona horaria loc
+func StartOfDay(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+}
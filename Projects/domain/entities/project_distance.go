@@ -0,0 +1,7 @@
+package entities
+
+// ProjectDistance es un proyecto junto con su distancia a un punto de referencia
+type ProjectDistance struct {
+	Project
+	DistanceMeters float64 `json:"distance_m"`
+}
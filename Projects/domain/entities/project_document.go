@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+// ProjectDocument es un archivo adjunto a un proyecto (planos, datos de campo...). Checksum es el
+// SHA-256 en hexadecimal del contenido y Location la ubicación del archivo en el almacenamiento
+type ProjectDocument struct {
+	Id          int       `json:"id"`
+	ProjectId   int       `json:"project_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Checksum    string    `json:"checksum"`
+	Location    string    `json:"-"`
+	UploadedBy  int       `json:"uploaded_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,73 @@
+package entities
+
+import "time"
+
+// Campos por los que se puede ordenar un listado de proyectos
+const (
+	SortById        = "id"
+	SortByNombre    = "nombre"
+	SortByFecha     = "fecha"
+	SortByCategoria = "categoria"
+)
+
+// BoundingBox delimita un área rectangular en grados WGS84
+type BoundingBox struct {
+	MinLng float64 `json:"min_lng"`
+	MinLat float64 `json:"min_lat"`
+	MaxLng float64 `json:"max_lng"`
+	MaxLat float64 `json:"max_lat"`
+}
+
+// ProjectFilter agrupa los filtros, el orden y la paginación de un listado de proyectos.
+// FechaDesde es inclusiva y FechaHasta exclusiva; el valor cero indica que no se filtra.
+// Tags son slugs de etiquetas que se combinan según TagMatch (all o any). Municipality, State y Country
+// filtran por la dirección geocodificada; Country acepta el nombre o el código ISO del país.
+// Statuses son estados del ciclo de vida, de los que el proyecto debe tener alguno
+type ProjectFilter struct {
+	Categoria    string
+	UserId       int
+	FechaDesde   time.Time
+	FechaHasta   time.Time
+	Nombre       string
+	BBox         *BoundingBox
+	Tags         []string
+	TagMatch     string
+	Statuses     []string
+	Municipality string
+	State        string
+	Country      string
+	SortField    string
+	SortDesc     bool
+	Cursor       string
+	Limit        int
+}
+
+// DateRange es un intervalo de fechas [From, To) en UTC; un extremo en cero queda abierto
+type DateRange struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// DateRangeParams son los parámetros con los que un cliente describe un rango de fechas:
+// from/to (AAAA-MM-DD), last (30d, 4w, 6m, 1y), month (AAAA-MM) o year (AAAA)
+type DateRangeParams struct {
+	From  string
+	To    string
+	Last  string
+	Month string
+	Year  string
+}
+
+// ProjectDateQuery busca los proyectos de un rango de fechas, hasta Limit
+type ProjectDateQuery struct {
+	Range DateRange
+	Limit int
+}
+
+// ProjectPage es una página de resultados de un listado filtrado
+type ProjectPage struct {
+	Projects   []Project `json:"data"`
+	Total      int       `json:"total"`
+	Limit      int       `json:"limit"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Tipos de geometría que admite el área de un proyecto
+const (
+	GeometryPolygon    = "Polygon"
+	GeometryLineString = "LineString"
+)
+
+// Position es un vértice [lng, lat] en WGS84
+type Position [2]float64
+
+// ProjectGeometry es el polígono (parcela) o la línea (trazo) levantados para un proyecto.
+// En un Polygon cada elemento de Coordinates es un anillo cerrado y el primero es el exterior;
+// en un LineString hay un único elemento con los vértices de la línea.
+// Se serializa como una geometría GeoJSON
+type ProjectGeometry struct {
+	Type        string
+	Coordinates [][]Position
+}
+
+func (g ProjectGeometry) MarshalJSON() ([]byte, error) {
+	var coordinates interface{} = g.Coordinates
+	if g.Type == GeometryLineString {
+		var line []Position
+		if len(g.Coordinates) > 0 {
+			line = g.Coordinates[0]
+		}
+		coordinates = line
+	}
+	return json.Marshal(struct {
+		Type        string      `json:"type"`
+		Coordinates interface{} `json:"coordinates"`
+	}{g.Type, coordinates})
+}
+
+func (g *ProjectGeometry) UnmarshalJSON(data []byte) error {
+	var raw GeoJSONGeometry
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch raw.Type {
+	case GeometryPolygon:
+		var rings [][][]float64
+		if err := json.Unmarshal(raw.Coordinates, &rings); err != nil {
+			return fmt.Errorf("las coordenadas del Polygon deben ser una lista de anillos")
+		}
+		g.Coordinates = make([][]Position, 0, len(rings))
+		for _, ring := range rings {
+			positions, err := toPositions(ring)
+			if err != nil {
+				return err
+			}
+			g.Coordinates = append(g.Coordinates, positions)
+		}
+	case GeometryLineString:
+		var line [][]float64
+		if err := json.Unmarshal(raw.Coordinates, &line); err != nil {
+			return fmt.Errorf("las coordenadas del LineString deben ser una lista de posiciones")
+		}
+		positions, err := toPositions(line)
+		if err != nil {
+			return err
+		}
+		g.Coordinates = [][]Position{positions}
+	default:
+		return fmt.Errorf("tipo de geometría %q no soportado; use Polygon o LineString", raw.Type)
+	}
+	g.Type = raw.Type
+	return nil
+}
+
+// toPositions convierte posiciones GeoJSON descartando la altitud
+func toPositions(raw [][]float64) ([]Position, error) {
+	positions := make([]Position, 0, len(raw))
+	for _, coordinates := range raw {
+		if len(coordinates) < 2 || len(coordinates) > 3 {
+			return nil, fmt.Errorf("cada posición debe ser [lng, lat] o [lng, lat, altitud]")
+		}
+		positions = append(positions, Position{coordinates[0], coordinates[1]})
+	}
+	return positions, nil
+}
@@ -0,0 +1,50 @@
+package entities
+
+const (
+	// MapModeClusters agrupa los proyectos en celdas de una cuadrícula
+	MapModeClusters = "clusters"
+	// MapModePoints devuelve los proyectos uno a uno
+	MapModePoints = "points"
+)
+
+// ProjectMapQuery pide los proyectos de un área del mapa para un nivel de zoom. Filter incluye el
+// bbox visible y el resto de filtros del listado; GridSize es el número de celdas por eje del mundo
+type ProjectMapQuery struct {
+	Filter   ProjectFilter
+	Zoom     int
+	GridSize int
+}
+
+// ProjectCluster es un grupo de proyectos de una celda de la cuadrícula. Lat y Lng son el promedio
+// de las ubicaciones y Bounds el rectángulo que las contiene; si el grupo tiene un solo proyecto,
+// ProjectId lo identifica
+type ProjectCluster struct {
+	Lat       float64     `json:"lat"`
+	Lng       float64     `json:"lng"`
+	Count     int         `json:"count"`
+	Bounds    BoundingBox `json:"bounds"`
+	ProjectId int         `json:"project_id,omitempty"`
+}
+
+// MapPoint es un proyecto con los datos mínimos para dibujarlo en el mapa
+type MapPoint struct {
+	Id             int     `json:"id"`
+	NombreProyecto string  `json:"nombre"`
+	Categoria      string  `json:"categoria"`
+	Status         string  `json:"status"`
+	Lat            float64 `json:"lat"`
+	Lng            float64 `json:"lng"`
+}
+
+// ProjectMap es la respuesta de una vista de mapa: grupos por celda o, con zoom alto, los proyectos
+// individuales. MaxCount es el grupo más grande, para escalar un mapa de calor; Truncated indica que
+// hay más puntos que el límite
+type ProjectMap struct {
+	Mode      string           `json:"mode"`
+	Zoom      int              `json:"zoom"`
+	Total     int              `json:"total"`
+	MaxCount  int              `json:"max_count"`
+	Clusters  []ProjectCluster `json:"clusters,omitempty"`
+	Points    []MapPoint       `json:"points,omitempty"`
+	Truncated bool             `json:"truncated,omitempty"`
+}
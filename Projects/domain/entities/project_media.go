@@ -0,0 +1,28 @@
+package entities
+
+import "time"
+
+// ProjectMedia es una imagen de la galería de un proyecto. Position define el orden de la galería
+// y la imagen marcada como portada es la que se expone en Project.Img
+type ProjectMedia struct {
+	Id         int        `json:"id"`
+	ProjectId  int        `json:"project_id"`
+	Url        string     `json:"url"`
+	Caption    string     `json:"caption"`
+	Position   int        `json:"position"`
+	IsCover    bool       `json:"is_cover"`
+	CapturedAt *time.Time `json:"captured_at,omitempty"`
+	Lat        *float64   `json:"lat,omitempty"`
+	Lng        *float64   `json:"lng,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// MediaUpload es una imagen recibida en una petición, guardada temporalmente en LocalPath
+// hasta que el worker la sube a Cloudinary
+type MediaUpload struct {
+	LocalPath  string
+	Caption    string
+	CapturedAt *time.Time
+	Lat        *float64
+	Lng        *float64
+}
@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+// ProjectReport reúne lo que se incluye en el reporte de entrega de un proyecto
+type ProjectReport struct {
+	Project      Project
+	Tags         []Tag
+	Images       []ReportImage
+	Measurements []Measurement
+	// MoreMeasurements indica que el proyecto tiene más puntos de los que se incluyen
+	MoreMeasurements bool
+	Language         string
+	Location         *time.Location
+	GeneratedAt      time.Time
+}
+
+// ReportImage es una imagen de la galería para el reporte; Data queda vacío si no se pudo descargar
+type ReportImage struct {
+	Media ProjectMedia
+	Data  []byte
+}
@@ -0,0 +1,50 @@
+package entities
+
+import "time"
+
+// Acciones que originan una revisión de un proyecto
+const (
+	RevisionActionCreate   = "create"
+	RevisionActionBaseline = "baseline"
+	RevisionActionUpdate   = "update"
+	RevisionActionRestore  = "restore"
+)
+
+// ProjectRevision es una copia inmutable del proyecto tal como quedó después de un cambio.
+// El número de revisión es la versión que alcanzó el proyecto con ese cambio, por lo que crece con
+// cada revisión pero puede saltar números (los cambios de estado también incrementan la versión)
+type ProjectRevision struct {
+	Id            int       `json:"id"`
+	ProjectId     int       `json:"project_id"`
+	Revision      int       `json:"revision"`
+	Action        string    `json:"action"`
+	EditorId      int       `json:"editor_id,omitempty"`
+	ChangedFields []string  `json:"changed_fields"`
+	PreviousImg   string    `json:"previous_img,omitempty"`
+	RestoredFrom  int       `json:"restored_from,omitempty"`
+	Snapshot      Project   `json:"snapshot"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ProjectHistoryPage es una página del historial de un proyecto, de la revisión más reciente a la
+// más antigua. NextBefore es el parámetro before de la página siguiente, o 0 si no hay más
+type ProjectHistoryPage struct {
+	Revisions  []ProjectRevision `json:"revisions"`
+	Limit      int               `json:"limit"`
+	NextBefore int               `json:"next_before,omitempty"`
+}
+
+// FieldChange es el valor de un campo antes y después de un cambio
+type FieldChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+// RevisionDiff son los campos que cambian entre dos revisiones de un proyecto
+type RevisionDiff struct {
+	ProjectId int           `json:"project_id"`
+	From      int           `json:"from"`
+	To        int           `json:"to"`
+	Changes   []FieldChange `json:"changes"`
+}
@@ -0,0 +1,16 @@
+package entities
+
+// ProjectSearchQuery describe una búsqueda de texto libre sobre los proyectos
+type ProjectSearchQuery struct {
+	Text   string
+	Terms  []string
+	UserId int
+	Limit  int
+}
+
+// ProjectSearchResult es un proyecto encontrado junto con su relevancia
+type ProjectSearchResult struct {
+	Project    Project           `json:"project"`
+	Score      float64           `json:"score"`
+	Highlights map[string]string `json:"highlights,omitempty"`
+}
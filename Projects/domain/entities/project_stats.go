@@ -1,13 +1,95 @@
 //geova-back-1/Projects/domain/entities/project_stats.go
 package entities
 
-type DailyProjectCount struct {
-    Date  string `json:"date"`
-    Count int    `json:"count"`
+import "time"
+
+// Granularidades de las series de estadísticas
+const (
+	StatsGranularityDay   = "day"
+	StatsGranularityWeek  = "week"
+	StatsGranularityMonth = "month"
+)
+
+// Alcance de las estadísticas: los proyectos de un usuario o los de toda la organización
+const (
+	StatsScopeUser         = "user"
+	StatsScopeOrganization = "organization"
+)
+
+// ProjectStatsQuery describe las estadísticas pedidas. UserId 0 agrega los proyectos de toda la
+// organización. Range ya está alineado al inicio de los periodos de Granularity en Location
+type ProjectStatsQuery struct {
+	UserId      int
+	Granularity string
+	Range       DateRange
+	Location    *time.Location
+}
+
+// ProjectStatsRecord son los datos de un proyecto que se usan para calcular las estadísticas
+type ProjectStatsRecord struct {
+	Fecha      time.Time
+	CategoryId int
+	Categoria  string
+	Status     string
+	UserId     int
+}
+
+// StatsBucket es un periodo de la serie. Period es 2025-11-15 (día), 2025-W46 (semana ISO) o 2025-11
+// (mes); From y To son el primer y el último día del periodo
+type StatsBucket struct {
+	Period string `json:"period"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Count  int    `json:"count"`
+}
+
+// CategoryStats es el número de proyectos de una categoría en el rango y en el rango anterior. Share
+// es el porcentaje del total del rango
+type CategoryStats struct {
+	CategoryId    int     `json:"category_id"`
+	Categoria     string  `json:"categoria"`
+	Count         int     `json:"count"`
+	Share         float64 `json:"share"`
+	PreviousCount int     `json:"previous_count"`
+}
+
+// UserProjectCount es el número de proyectos de un usuario en el rango
+type UserProjectCount struct {
+	UserId int `json:"user_id"`
+	Count  int `json:"count"`
+}
+
+// StatsComparison compara el rango con el inmediatamente anterior del mismo número de periodos.
+// ChangePercent es nulo si el rango anterior no tuvo proyectos
+type StatsComparison struct {
+	From           string        `json:"from"`
+	To             string        `json:"to"`
+	PreviousCount  int           `json:"previous_count"`
+	Change         int           `json:"change"`
+	ChangePercent  *float64      `json:"change_percent"`
+	PreviousSeries []StatsBucket `json:"previous_series"`
+}
+
+// OrganizationStats son los agregados que solo se calculan para toda la organización
+type OrganizationStats struct {
+	ActiveUsers    int                `json:"active_users"`
+	AveragePerUser float64            `json:"average_per_user"`
+	TopUsers       []UserProjectCount `json:"top_users"`
 }
 
+// ProjectStats son las estadísticas de proyectos de un rango de fechas. Series incluye todos los
+// periodos del rango, también los que no tienen proyectos. From y To son el primer y el último día
 type ProjectStats struct {
-    UserId     int                 `json:"user_id"`
-    TotalCount int                 `json:"total_count"`
-    Daily      []DailyProjectCount `json:"daily"`
-}
\ No newline at end of file
+	Scope        string             `json:"scope"`
+	UserId       int                `json:"user_id,omitempty"`
+	Timezone     string             `json:"timezone"`
+	Granularity  string             `json:"granularity"`
+	From         string             `json:"from"`
+	To           string             `json:"to"`
+	TotalCount   int                `json:"total_count"`
+	Series       []StatsBucket      `json:"series"`
+	ByCategory   []CategoryStats    `json:"by_category"`
+	ByStatus     map[string]int     `json:"by_status"`
+	Comparison   StatsComparison    `json:"comparison"`
+	Organization *OrganizationStats `json:"organization,omitempty"`
+}
@@ -0,0 +1,33 @@
+package entities
+
+import "time"
+
+// Estados del ciclo de vida de un proyecto
+const (
+	StatusDraft      = "draft"
+	StatusPlanned    = "planned"
+	StatusInField    = "in_field"
+	StatusProcessing = "processing"
+	StatusDelivered  = "delivered"
+	StatusArchived   = "archived"
+)
+
+// ProjectStatuses son los estados en el orden natural del ciclo de vida
+var ProjectStatuses = []string{StatusDraft, StatusPlanned, StatusInField, StatusProcessing, StatusDelivered, StatusArchived}
+
+// ProjectStatusWorkflow define a qué estados se puede pasar desde cada estado
+type ProjectStatusWorkflow struct {
+	Statuses    []string            `json:"statuses"`
+	Transitions map[string][]string `json:"transitions"`
+}
+
+// ProjectStatusTransition es un cambio de estado de un proyecto, con el comentario de quien lo hizo
+type ProjectStatusTransition struct {
+	Id         int       `json:"id"`
+	ProjectId  int       `json:"project_id"`
+	FromStatus string    `json:"from"`
+	ToStatus   string    `json:"to"`
+	Comment    string    `json:"comment"`
+	UserId     int       `json:"user_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,31 @@
+package entities
+
+import "time"
+
+// ChecklistItem es una tarea de la lista de verificación de un proyecto
+type ChecklistItem struct {
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+// ProjectTemplate es una plantilla reutilizable para levantamientos que se repiten: la categoría por
+// defecto, el esqueleto de la descripción y la lista de verificación con la que empiezan los proyectos
+type ProjectTemplate struct {
+	Id          int       `json:"id"`
+	Name        string    `json:"name"`
+	CategoryId  int       `json:"category_id"`
+	Categoria   string    `json:"categoria"`
+	Descripcion string    `json:"descripcion"`
+	Checklist   []string  `json:"checklist"`
+	UserId      int       `json:"user_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CloneOptions indica cómo se copia un proyecto. Sin NombreProyecto se usa el del original con el
+// sufijo "(copia)" y con UserId 0 se conserva el autor del original
+type CloneOptions struct {
+	NombreProyecto string
+	IncludeImages  bool
+	UserId         int
+}
@@ -1,13 +1,27 @@
 package entities
 
+import "time"
+
 type Project struct {
 	Id int
 	NombreProyecto string 	
-	Fecha string			
+	Fecha time.Time			
 	Categoria string		
+	CategoryId int
 	Descripcion string
 	Img string
 	Lat float64
 	Lng float64
 	UserId int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Version int
+	PointCount int
+	Geometry *ProjectGeometry
+	AreaM2 float64
+	PerimeterM float64
+	Address *ProjectAddress
+	Status string
+	Checklist []ChecklistItem
+	TemplateId int
 }
\ No newline at end of file
@@ -0,0 +1,20 @@
+package entities
+
+import "time"
+
+// Formatos de las exportaciones tabulares
+const (
+	SpreadsheetCSV  = "csv"
+	SpreadsheetXLSX = "xlsx"
+)
+
+// SpreadsheetExport indica cómo exportar los proyectos como tabla: Columns es la lista de columnas
+// separadas por comas (vacía para las columnas por defecto), Language el idioma de los encabezados
+// ("es" o "en"), Location la zona horaria de las fechas y Delimiter el separador del CSV
+type SpreadsheetExport struct {
+	Format    string
+	Columns   string
+	Language  string
+	Location  *time.Location
+	Delimiter rune
+}
@@ -0,0 +1,16 @@
+package entities
+
+// Modos de combinación de varias etiquetas en un filtro
+const (
+	TagMatchAll = "all"
+	TagMatchAny = "any"
+)
+
+// Tag es una etiqueta libre que puede asignarse a muchos proyectos; Count es el número de proyectos
+// que la usan y solo se informa en el autocompletado
+type Tag struct {
+	Id     int    `json:"id"`
+	Slug   string `json:"slug"`
+	Nombre string `json:"nombre"`
+	Count  int    `json:"count,omitempty"`
+}
@@ -0,0 +1,18 @@
+package repository
+
+import "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+
+// CategoryRepository administra el catálogo de categorías y sus personalizaciones por organización
+type CategoryRepository interface {
+	Save(category entities.Category) (int, error)
+	// Update también actualiza el nombre copiado en los proyectos de la categoría
+	Update(category entities.Category) error
+	Delete(id int) error
+	FindById(id int) (*entities.Category, error)
+	FindBySlug(slug string) (*entities.Category, error)
+	FindAll() ([]entities.Category, error)
+	CountProjects(id int) (int, error)
+	FindOverrides(orgId int) ([]entities.CategoryOverride, error)
+	SaveOverride(override entities.CategoryOverride) error
+	DeleteOverride(categoryId, orgId int) error
+}
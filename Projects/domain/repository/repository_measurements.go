@@ -0,0 +1,15 @@
+package repository
+
+import "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+
+// MeasurementRepository guarda los puntos de medición de los proyectos y mantiene actualizado el
+// conteo de puntos de cada proyecto
+type MeasurementRepository interface {
+	// SaveBatch inserta todos los puntos en una transacción: se guardan todos o ninguno
+	SaveBatch(projectId int, measurements []entities.Measurement) error
+	// FindByProject devuelve hasta limit puntos con Id mayor que afterId, ordenados por Id
+	FindByProject(projectId, afterId, limit int) ([]entities.Measurement, error)
+	FindById(projectId, measurementId int) (*entities.Measurement, error)
+	Update(measurement entities.Measurement) error
+	Delete(projectId, measurementId int) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+
+// ProjectBulkRepository aplica operaciones masivas en una sola transacción. Si alguno de los proyectos
+// ya no está en la versión de su BulkTarget no se modifica ninguno y se devuelve ErrVersionConflict.
+// Los cambios de categoría y de autor guardan la revisión de cada proyecto en la misma transacción;
+// de revision solo se usan la acción y el editor
+type ProjectBulkRepository interface {
+	DeleteProjects(targets []entities.BulkTarget) error
+	UpdateCategory(targets []entities.BulkTarget, categoryId int, categoria string, revision entities.ProjectRevision) error
+	// UpdateOwner devuelve ErrInvalidInput si el usuario no existe
+	UpdateOwner(targets []entities.BulkTarget, userId int, revision entities.ProjectRevision) error
+	// ChangeTags asigna add (creando las etiquetas que no existan) y quita las etiquetas con los slugs de
+	// remove; las etiquetas no forman parte de la versión del proyecto
+	ChangeTags(projectIds []int, add []entities.Tag, remove []string) error
+	// ChangeStatuses aplica a cada proyecto de targets la transición de la misma posición en transitions
+	ChangeStatuses(targets []entities.BulkTarget, transitions []entities.ProjectStatusTransition) error
+}
@@ -0,0 +1,11 @@
+package repository
+
+import "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+
+type ProjectDocumentRepository interface {
+	Save(document entities.ProjectDocument) (int, error)
+	FindByProject(projectId int) ([]entities.ProjectDocument, error)
+	FindById(projectId, documentId int) (*entities.ProjectDocument, error)
+	FindByChecksum(projectId int, checksum string) (*entities.ProjectDocument, error)
+	Delete(projectId, documentId int) error
+}
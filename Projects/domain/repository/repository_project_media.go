@@ -0,0 +1,16 @@
+package repository
+
+import "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+
+// ProjectMediaRepository guarda la galería de imágenes de cada proyecto. Las operaciones que cambian
+// la portada mantienen sincronizado projects.Img con la URL de la imagen de portada: si Img cambia, en la
+// misma transacción se incrementa la versión del proyecto y se guarda la revisión a nombre de editorId
+type ProjectMediaRepository interface {
+	FindByProject(projectId int) ([]entities.ProjectMedia, error)
+	// Add agrega las imágenes al final de la galería; si ninguna es portada y la galería no tiene,
+	// la primera pasa a serlo
+	Add(projectId int, media []entities.ProjectMedia, editorId int) error
+	Reorder(projectId int, mediaIds []int) error
+	SetCover(projectId, mediaId, editorId int) error
+	Delete(projectId, mediaId, editorId int) error
+}
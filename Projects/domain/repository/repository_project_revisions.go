@@ -0,0 +1,12 @@
+package repository
+
+import "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+
+// ProjectRevisionRepository consulta el historial inmutable de cambios de los proyectos. Las revisiones
+// las guardan ProjectRepository y ProjectBulkRepository en la misma transacción que el cambio
+type ProjectRevisionRepository interface {
+	// FindRevisions devuelve hasta limit revisiones con número menor que before (before 0 empieza por
+	// la más reciente), de la más reciente a la más antigua
+	FindRevisions(projectId, before, limit int) ([]entities.ProjectRevision, error)
+	FindRevision(projectId, revision int) (*entities.ProjectRevision, error)
+}
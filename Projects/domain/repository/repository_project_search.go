@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// ProjectSearchRepository busca proyectos por texto y los devuelve ordenados por relevancia
+type ProjectSearchRepository interface {
+	Search(query entities.ProjectSearchQuery) ([]entities.ProjectSearchResult, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+
+// ProjectStatusRepository guarda el estado de los proyectos y el historial de sus transiciones
+type ProjectStatusRepository interface {
+	// ChangeStatus aplica la transición y la registra en una transacción. Falla con ErrVersionConflict
+	// si el proyecto ya no está en version o en transition.FromStatus
+	ChangeStatus(transition entities.ProjectStatusTransition, version int) error
+	// FindTransitions devuelve las transiciones del proyecto de la más reciente a la más antigua
+	FindTransitions(projectId int) ([]entities.ProjectStatusTransition, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+
+// ProjectTemplateRepository guarda las plantillas de proyecto
+type ProjectTemplateRepository interface {
+	Save(template entities.ProjectTemplate) (int, error)
+	// FindAll devuelve las plantillas ordenadas por nombre
+	FindAll() ([]entities.ProjectTemplate, error)
+	FindById(id int) (*entities.ProjectTemplate, error)
+	Update(template entities.ProjectTemplate) error
+	Delete(id int) error
+}
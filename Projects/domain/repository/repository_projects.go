@@ -1,20 +1,37 @@
 package repository
 
 import (
-
 	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
 )
 
 type ProjectRepository interface {
-	Save(proyect entities.Project) error
+	// Save crea el proyecto y, en la misma transacción, su primera revisión. De revision solo se usan
+	// la acción y el editor; la copia y el número los completa el repositorio
+	Save(proyect entities.Project, revision entities.ProjectRevision) (int, error)
 	FindById(id int) (*entities.Project, error)
 	FindAll() ([]entities.Project, error)
-	Update(proyect entities.Project) error
+	FindFiltered(filter entities.ProjectFilter) (*entities.ProjectPage, error)
+	StreamFiltered(filter entities.ProjectFilter, fn func(entities.Project) error) error
+	// Update guarda el proyecto solo si sigue en proyect.Version (si no, ErrVersionConflict) y, en la
+	// misma transacción, la revisión del cambio. Si la revisión no se puede guardar no se aplica el cambio
+	Update(proyect entities.Project, revision entities.ProjectRevision) error
+	// UpdateAddress guarda la dirección geocodificada sin cambiar la versión del proyecto
+	UpdateAddress(id int, address entities.ProjectAddress) error
 	Delete (id int) error
 	FindByName(nombre string) ([]entities.Project, error)
-	FindByCategory(categoria string) ([]entities.Project, error)
-	FindByDate(fecha string) ([]entities.Project, error)
+	FindByCategory(slug string) ([]entities.Project, error)
+	// FindByDateRange devuelve hasta query.Limit proyectos del rango, del más reciente al más antiguo
+	FindByDateRange(query entities.ProjectDateQuery) ([]entities.Project, error)
 	FindByUserId(userId int) ([]entities.Project, error)
-	GetProjectsStats(userId int, days int) ([]entities.DailyProjectCount, error)
+	// StreamStatsRecords llama a fn con los datos para estadísticas de cada proyecto con Fecha en
+	// [dateRange.From, dateRange.To); userId 0 incluye los proyectos de todos los usuarios
+	StreamStatsRecords(userId int, dateRange entities.DateRange, fn func(entities.ProjectStatsRecord) error) error
 	GetTotalProjectsByUser(userId string) (int, error)
+	FindNear(lat, lng, radiusMeters float64, limit int) ([]entities.Project, error)
+	FindWithin(bbox entities.BoundingBox, limit int) ([]entities.Project, error)
+	FindNearest(lat, lng float64, k int) ([]entities.Project, error)
+	// ClusterWithin agrupa los proyectos que cumplen los filtros por celda de la cuadrícula del mapa
+	ClusterWithin(query entities.ProjectMapQuery) ([]entities.ProjectCluster, error)
+	// FindMapPoints devuelve hasta limit proyectos que cumplen los filtros, del más reciente al más antiguo
+	FindMapPoints(filter entities.ProjectFilter, limit int) ([]entities.MapPoint, error)
 }
@@ -0,0 +1,12 @@
+package repository
+
+import "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+
+type TagRepository interface {
+	FindByProject(projectId int) ([]entities.Tag, error)
+	// AddToProject crea las etiquetas que no existan y las asigna al proyecto; las ya asignadas se ignoran
+	AddToProject(projectId int, tags []entities.Tag) error
+	RemoveFromProject(projectId int, slug string) error
+	// Autocomplete devuelve las etiquetas en uso cuyo slug empieza con prefix, de la más usada a la menos usada
+	Autocomplete(prefix string, limit int) ([]entities.Tag, error)
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+var (
+	slugPattern  = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	colorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+)
+
+// maxCategoryIconLength coincide con el tamaño de la columna icon
+const maxCategoryIconLength = 50
+
+// Slugify convierte un nombre en slug sin acentos ni mayúsculas: "Topografía Urbana" -> "topografia-urbana"
+func Slugify(value string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range NormalizeText(strings.TrimSpace(value)) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return b.String()
+}
+
+// ValidateCategory normaliza y valida una categoría antes de guardarla. Si no trae slug se deriva
+// del nombre y si no trae color se asigna uno estable de la paleta
+func ValidateCategory(category *entities.Category) error {
+	category.Nombre = strings.TrimSpace(category.Nombre)
+	if category.Nombre == "" {
+		return fmt.Errorf("%w: el nombre de la categoría es obligatorio", entities.ErrInvalidInput)
+	}
+
+	category.Slug = strings.TrimSpace(category.Slug)
+	if category.Slug == "" {
+		category.Slug = Slugify(category.Nombre)
+	}
+	if !slugPattern.MatchString(category.Slug) {
+		return fmt.Errorf("%w: el slug solo admite minúsculas, números y guiones", entities.ErrInvalidInput)
+	}
+
+	if category.Color == "" {
+		category.Color = "#" + CategoryColor(category.Nombre)
+	}
+	if err := validateCategoryStyle(category.Color, category.Icon); err != nil {
+		return err
+	}
+	category.Color = strings.ToUpper(category.Color)
+	return nil
+}
+
+// ValidateCategoryOverride valida la personalización de una categoría para una organización
+func ValidateCategoryOverride(override *entities.CategoryOverride) error {
+	if override.OrgId <= 0 {
+		return fmt.Errorf("%w: orgId debe ser mayor a 0", entities.ErrInvalidInput)
+	}
+	override.Nombre = strings.TrimSpace(override.Nombre)
+	if err := validateCategoryStyle(override.Color, override.Icon); err != nil {
+		return err
+	}
+	override.Color = strings.ToUpper(override.Color)
+	return nil
+}
+
+func validateCategoryStyle(color, icon string) error {
+	if color != "" && !colorPattern.MatchString(color) {
+		return fmt.Errorf("%w: el color debe tener el formato #RRGGBB", entities.ErrInvalidInput)
+	}
+	if len(icon) > maxCategoryIconLength {
+		return fmt.Errorf("%w: el ícono no puede superar %d caracteres", entities.ErrInvalidInput, maxCategoryIconLength)
+	}
+	return nil
+}
+
+// ApplyCategoryOverrides devuelve el catálogo tal como lo ve una organización: con sus nombres,
+// colores e íconos propios y sin las categorías que ocultó
+func ApplyCategoryOverrides(categories []entities.Category, overrides []entities.CategoryOverride) []entities.Category {
+	byCategory := make(map[int]entities.CategoryOverride, len(overrides))
+	for _, override := range overrides {
+		byCategory[override.CategoryId] = override
+	}
+
+	result := make([]entities.Category, 0, len(categories))
+	for _, category := range categories {
+		override, ok := byCategory[category.Id]
+		if !ok {
+			result = append(result, category)
+			continue
+		}
+		if override.Hidden {
+			continue
+		}
+		if override.Nombre != "" {
+			category.Nombre = override.Nombre
+		}
+		if override.Color != "" {
+			category.Color = override.Color
+		}
+		if override.Icon != "" {
+			category.Icon = override.Icon
+		}
+		category.Overridden = true
+		result = append(result, category)
+	}
+	return result
+}
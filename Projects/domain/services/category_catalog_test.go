@@ -0,0 +1,30 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+func TestSlugifyAndValidateCategory(t *testing.T) {
+	if got := Slugify("  Topografía Urbana "); got != "topografia-urbana" {
+		t.Errorf("slug inesperado: %q", got)
+	}
+	if got := Slugify("TOPOGRAFIA"); got != "topografia" {
+		t.Errorf("slug inesperado: %q", got)
+	}
+
+	category := entities.Category{Nombre: "Topografía Urbana"}
+	if err := ValidateCategory(&category); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if category.Slug != "topografia-urbana" || len(category.Color) != 7 || category.Color[0] != '#' {
+		t.Errorf("valores por defecto inesperados: %+v", category)
+	}
+
+	invalid := entities.Category{Nombre: "Catastro", Color: "rojo"}
+	if err := ValidateCategory(&invalid); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba ErrInvalidInput por color inválido, obtenido %v", err)
+	}
+}
@@ -2,4 +2,6 @@ package services
 
 type ICloudinaryService interface {
 	UploadImage(localPath string) (string, error)
+	// DeleteImage elimina una imagen subida con UploadImage a partir de la URL que devolvió
+	DeleteImage(url string) error
 }
@@ -0,0 +1,356 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// ellipsoid define el elipsoide de un sistema de referencia por su semieje mayor y su achatamiento
+type ellipsoid struct {
+	a, f float64
+}
+
+var (
+	wgs84Ellipsoid = ellipsoid{a: 6378137, f: 1 / 298.257223563}
+	grs80Ellipsoid = ellipsoid{a: 6378137, f: 1 / 298.257222101}
+)
+
+// coordinateSystem es un CRS soportado con sus conversiones desde y hacia WGS84 en grados.
+// Los datums soportados (WGS84, ITRF2008) coinciden con WGS84 a nivel submétrico, por lo que no se
+// aplica transformación de datum
+type coordinateSystem struct {
+	name    string
+	forward func(lat, lng float64) (x, y float64)
+	inverse func(x, y float64) (lat, lng float64)
+}
+
+// lookupCRS devuelve el CRS con el código EPSG indicado. Se soportan WGS84 (4326), Web Mercator (3857),
+// UTM WGS84 norte (32601-32660) y sur (32701-32760), UTM ITRF2008 de México (6366-6371, zonas 11N a 16N)
+// y la cónica conforme de Lambert de INEGI (6362 ITRF92 y 6372 ITRF2008)
+func lookupCRS(code int) (coordinateSystem, error) {
+	switch {
+	case code == entities.CRSWGS84:
+		return coordinateSystem{
+			name:    "WGS 84",
+			forward: func(lat, lng float64) (float64, float64) { return lng, lat },
+			inverse: func(x, y float64) (float64, float64) { return y, x },
+		}, nil
+	case code == 3857:
+		return webMercator(), nil
+	case code >= 32601 && code <= 32660:
+		return utm(fmt.Sprintf("WGS 84 / UTM zone %dN", code-32600), wgs84Ellipsoid, code-32600, false), nil
+	case code >= 32701 && code <= 32760:
+		return utm(fmt.Sprintf("WGS 84 / UTM zone %dS", code-32700), wgs84Ellipsoid, code-32700, true), nil
+	case code >= 6366 && code <= 6371:
+		zone := code - 6366 + 11
+		return utm(fmt.Sprintf("Mexico ITRF2008 / UTM zone %dN", zone), grs80Ellipsoid, zone, false), nil
+	case code == 6362:
+		return mexicoLambert("Mexico ITRF92 / LCC"), nil
+	case code == 6372:
+		return mexicoLambert("Mexico ITRF2008 / LCC"), nil
+	}
+	return coordinateSystem{}, fmt.Errorf("%w: el sistema de referencia EPSG:%d no está soportado", entities.ErrInvalidInput, code)
+}
+
+// ParseCRS interpreta un código de sistema de referencia: "EPSG:32614", "32614", "urn:ogc:def:crs:EPSG::32614"
+// o "CRS84". Vacío equivale a WGS84
+func ParseCRS(value string) (int, error) {
+	text := strings.ToUpper(strings.TrimSpace(value))
+	switch text {
+	case "", "CRS84", "URN:OGC:DEF:CRS:OGC:1.3:CRS84":
+		return entities.CRSWGS84, nil
+	}
+	for _, prefix := range []string{"URN:OGC:DEF:CRS:EPSG::", "EPSG:"} {
+		text = strings.TrimPrefix(text, prefix)
+	}
+	code, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("%w: sistema de referencia %q inválido; use un código EPSG como EPSG:32614", entities.ErrInvalidInput, value)
+	}
+	if _, err := lookupCRS(code); err != nil {
+		return 0, err
+	}
+	return code, nil
+}
+
+// CRSName devuelve el identificador EPSG:n de un código
+func CRSName(code int) string {
+	return "EPSG:" + strconv.Itoa(code)
+}
+
+// ToWGS84 convierte x/y (este/norte, o lng/lat en WGS84) del CRS indicado a latitud y longitud WGS84
+func ToWGS84(code int, x, y float64) (float64, float64, error) {
+	crs, err := lookupCRS(code)
+	if err != nil {
+		return 0, 0, err
+	}
+	if math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0) {
+		return 0, 0, fmt.Errorf("%w: coordenadas no numéricas", entities.ErrInvalidInput)
+	}
+	lat, lng := crs.inverse(x, y)
+	if err := ValidateCoordinates(lat, lng); err != nil {
+		return 0, 0, fmt.Errorf("%w: las coordenadas (%v, %v) en %s (%s) quedan fuera de WGS84: %v", entities.ErrInvalidInput, x, y, crs.name, CRSName(code), err)
+	}
+	return lat, lng, nil
+}
+
+// FromWGS84 convierte latitud y longitud WGS84 a x/y del CRS indicado
+func FromWGS84(code int, lat, lng float64) (float64, float64, error) {
+	crs, err := lookupCRS(code)
+	if err != nil {
+		return 0, 0, err
+	}
+	x, y := crs.forward(lat, lng)
+	if math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0) {
+		return 0, 0, fmt.Errorf("%w: el punto (%v, %v) no se puede expresar en %s (%s)", entities.ErrInvalidInput, lat, lng, crs.name, CRSName(code))
+	}
+	return x, y, nil
+}
+
+// ReprojectGeometry devuelve una copia de la geometría convertida del CRS from al CRS to
+func ReprojectGeometry(geometry *entities.ProjectGeometry, from, to int) (*entities.ProjectGeometry, error) {
+	if geometry == nil {
+		return nil, nil
+	}
+	result := &entities.ProjectGeometry{Type: geometry.Type, Coordinates: make([][]entities.Position, len(geometry.Coordinates))}
+	for i, ring := range geometry.Coordinates {
+		result.Coordinates[i] = make([]entities.Position, len(ring))
+		for j, position := range ring {
+			converted, err := reprojectPosition(position, from, to)
+			if err != nil {
+				return nil, err
+			}
+			result.Coordinates[i][j] = converted
+		}
+	}
+	return result, nil
+}
+
+func reprojectPosition(position entities.Position, from, to int) (entities.Position, error) {
+	if from == to {
+		return position, nil
+	}
+	lat, lng, err := ToWGS84(from, position[0], position[1])
+	if err != nil {
+		return position, err
+	}
+	x, y, err := FromWGS84(to, lat, lng)
+	return entities.Position{x, y}, err
+}
+
+// ReprojectGeoJSONGeometry convierte en su lugar las coordenadas de una geometría GeoJSON de cualquier
+// tipo; la altitud se conserva
+func ReprojectGeoJSONGeometry(geometry *entities.GeoJSONGeometry, from, to int) error {
+	if geometry == nil || from == to {
+		return nil
+	}
+	var coordinates interface{}
+	if err := json.Unmarshal(geometry.Coordinates, &coordinates); err != nil {
+		return fmt.Errorf("%w: coordenadas GeoJSON inválidas", entities.ErrInvalidInput)
+	}
+	converted, err := reprojectNested(coordinates, from, to)
+	if err != nil {
+		return err
+	}
+	geometry.Coordinates, err = json.Marshal(converted)
+	return err
+}
+
+// reprojectNested recorre los arreglos anidados de coordenadas hasta llegar a cada posición
+func reprojectNested(value interface{}, from, to int) (interface{}, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: coordenadas GeoJSON inválidas", entities.ErrInvalidInput)
+	}
+	if len(items) >= 2 {
+		x, okX := items[0].(float64)
+		y, okY := items[1].(float64)
+		if okX && okY {
+			converted, err := reprojectPosition(entities.Position{x, y}, from, to)
+			if err != nil {
+				return nil, err
+			}
+			items[0], items[1] = converted[0], converted[1]
+			return items, nil
+		}
+	}
+	for i, item := range items {
+		converted, err := reprojectNested(item, from, to)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = converted
+	}
+	return items, nil
+}
+
+// NamedGeoJSONCRS devuelve el miembro crs de GeoJSON 2008 para un código EPSG
+func NamedGeoJSONCRS(code int) *entities.GeoJSONCRS {
+	return &entities.GeoJSONCRS{
+		Type:       "name",
+		Properties: map[string]string{"name": "urn:ogc:def:crs:EPSG::" + strconv.Itoa(code)},
+	}
+}
+
+// ParseGeoJSONCRS devuelve el código EPSG del miembro crs de un GeoJSON; sin crs es WGS84
+func ParseGeoJSONCRS(crs *entities.GeoJSONCRS) (int, error) {
+	if crs == nil {
+		return entities.CRSWGS84, nil
+	}
+	if crs.Type != "name" {
+		return 0, fmt.Errorf("%w: solo se admite el miembro crs de tipo name", entities.ErrInvalidInput)
+	}
+	return ParseCRS(crs.Properties["name"])
+}
+
+// ProjectToCRS agrega al proyecto su ubicación y su geometría en el CRS indicado
+func ProjectToCRS(project entities.Project, code int) (entities.ProjectInCRS, error) {
+	result := entities.ProjectInCRS{Project: project, CRS: CRSName(code)}
+	var err error
+	if result.X, result.Y, err = FromWGS84(code, project.Lat, project.Lng); err != nil {
+		return result, err
+	}
+	result.ProjectedGeometry, err = ReprojectGeometry(project.Geometry, entities.CRSWGS84, code)
+	return result, err
+}
+
+func webMercator() coordinateSystem {
+	radius := wgs84Ellipsoid.a
+	// Más allá de esta latitud la proyección no está definida
+	maxLat := 85.0511287798066
+	return coordinateSystem{
+		name: "WGS 84 / Pseudo-Mercator",
+		forward: func(lat, lng float64) (float64, float64) {
+			if math.Abs(lat) > maxLat {
+				return math.NaN(), math.NaN()
+			}
+			return radius * toRadians(lng), radius * math.Log(math.Tan(math.Pi/4+toRadians(lat)/2))
+		},
+		inverse: func(x, y float64) (float64, float64) {
+			return toDegrees(2*math.Atan(math.Exp(y/radius)) - math.Pi/2), toDegrees(x / radius)
+		},
+	}
+}
+
+// utm es la proyección transversa de Mercator de una zona UTM (fórmulas de Snyder, USGS 1395)
+func utm(name string, e ellipsoid, zone int, south bool) coordinateSystem {
+	const k0 = 0.9996
+	const falseEasting = 500000.0
+	falseNorthing := 0.0
+	if south {
+		falseNorthing = 10000000
+	}
+	lng0 := toRadians(float64(zone*6 - 183))
+
+	e2 := e.f * (2 - e.f)
+	e4, e6 := e2*e2, e2*e2*e2
+	ep2 := e2 / (1 - e2)
+	meridianArc := func(phi float64) float64 {
+		return e.a * ((1-e2/4-3*e4/64-5*e6/256)*phi -
+			(3*e2/8+3*e4/32+45*e6/1024)*math.Sin(2*phi) +
+			(15*e4/256+45*e6/1024)*math.Sin(4*phi) -
+			(35*e6/3072)*math.Sin(6*phi))
+	}
+
+	return coordinateSystem{
+		name: name,
+		forward: func(lat, lng float64) (float64, float64) {
+			phi := toRadians(lat)
+			sin, cos, tan := math.Sin(phi), math.Cos(phi), math.Tan(phi)
+			n := e.a / math.Sqrt(1-e2*sin*sin)
+			t := tan * tan
+			c := ep2 * cos * cos
+			a := cos * (toRadians(lng) - lng0)
+
+			x := falseEasting + k0*n*(a+(1-t+c)*math.Pow(a, 3)/6+
+				(5-18*t+t*t+72*c-58*ep2)*math.Pow(a, 5)/120)
+			y := falseNorthing + k0*(meridianArc(phi)+n*tan*(a*a/2+
+				(5-t+9*c+4*c*c)*math.Pow(a, 4)/24+
+				(61-58*t+t*t+600*c-330*ep2)*math.Pow(a, 6)/720))
+			return x, y
+		},
+		inverse: func(x, y float64) (float64, float64) {
+			m := (y - falseNorthing) / k0
+			mu := m / (e.a * (1 - e2/4 - 3*e4/64 - 5*e6/256))
+			e1 := (1 - math.Sqrt(1-e2)) / (1 + math.Sqrt(1-e2))
+			phi1 := mu + (3*e1/2-27*math.Pow(e1, 3)/32)*math.Sin(2*mu) +
+				(21*e1*e1/16-55*math.Pow(e1, 4)/32)*math.Sin(4*mu) +
+				(151*math.Pow(e1, 3)/96)*math.Sin(6*mu) +
+				(1097*math.Pow(e1, 4)/512)*math.Sin(8*mu)
+
+			sin, cos, tan := math.Sin(phi1), math.Cos(phi1), math.Tan(phi1)
+			c1 := ep2 * cos * cos
+			t1 := tan * tan
+			n1 := e.a / math.Sqrt(1-e2*sin*sin)
+			r1 := e.a * (1 - e2) / math.Pow(1-e2*sin*sin, 1.5)
+			d := (x - falseEasting) / (n1 * k0)
+
+			phi := phi1 - (n1*tan/r1)*(d*d/2-
+				(5+3*t1+10*c1-4*c1*c1-9*ep2)*math.Pow(d, 4)/24+
+				(61+90*t1+298*c1+45*t1*t1-252*ep2-3*c1*c1)*math.Pow(d, 6)/720)
+			lng := lng0 + (d-(1+2*t1+c1)*math.Pow(d, 3)/6+
+				(5-2*c1+28*t1-3*c1*c1+8*ep2+24*t1*t1)*math.Pow(d, 5)/120)/cos
+			return toDegrees(phi), toDegrees(lng)
+		},
+	}
+}
+
+// mexicoLambert es la cónica conforme de Lambert con dos paralelos que INEGI usa para todo el país
+func mexicoLambert(name string) coordinateSystem {
+	return lambertConformalConic(name, grs80Ellipsoid, 17.5, 29.5, 12, -102, 2500000, 0)
+}
+
+// lambertConformalConic implementa la proyección cónica conforme de Lambert con dos paralelos estándar
+// sobre el elipsoide (fórmulas de Snyder, USGS 1395)
+func lambertConformalConic(name string, e ellipsoid, lat1, lat2, lat0, lng0, falseEasting, falseNorthing float64) coordinateSystem {
+	ecc := math.Sqrt(e.f * (2 - e.f))
+	m := func(phi float64) float64 {
+		return math.Cos(phi) / math.Sqrt(1-ecc*ecc*math.Sin(phi)*math.Sin(phi))
+	}
+	t := func(phi float64) float64 {
+		sin := math.Sin(phi)
+		return math.Tan(math.Pi/4-phi/2) / math.Pow((1-ecc*sin)/(1+ecc*sin), ecc/2)
+	}
+
+	phi1, phi2 := toRadians(lat1), toRadians(lat2)
+	n := (math.Log(m(phi1)) - math.Log(m(phi2))) / (math.Log(t(phi1)) - math.Log(t(phi2)))
+	f := m(phi1) / (n * math.Pow(t(phi1), n))
+	rho0 := e.a * f * math.Pow(t(toRadians(lat0)), n)
+	lambda0 := toRadians(lng0)
+
+	return coordinateSystem{
+		name: name,
+		forward: func(lat, lng float64) (float64, float64) {
+			rho := e.a * f * math.Pow(t(toRadians(lat)), n)
+			theta := n * (toRadians(lng) - lambda0)
+			return falseEasting + rho*math.Sin(theta), falseNorthing + rho0 - rho*math.Cos(theta)
+		},
+		inverse: func(x, y float64) (float64, float64) {
+			dx, dy := x-falseEasting, rho0-(y-falseNorthing)
+			if n < 0 {
+				dx, dy = -dx, -dy
+			}
+			rho := math.Copysign(math.Hypot(dx, dy), n)
+			theta := math.Atan2(dx, dy)
+			tp := math.Pow(rho/(e.a*f), 1/n)
+
+			// La latitud se obtiene por iteración; converge en pocas vueltas
+			phi := math.Pi/2 - 2*math.Atan(tp)
+			for i := 0; i < 15; i++ {
+				sin := math.Sin(phi)
+				next := math.Pi/2 - 2*math.Atan(tp*math.Pow((1-ecc*sin)/(1+ecc*sin), ecc/2))
+				if math.Abs(next-phi) < 1e-12 {
+					phi = next
+					break
+				}
+				phi = next
+			}
+			return toDegrees(phi), toDegrees(theta/n + lambda0)
+		},
+	}
+}
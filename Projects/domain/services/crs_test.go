@@ -0,0 +1,41 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+func TestCoordinateReprojection(t *testing.T) {
+	for value, want := range map[string]int{"": 4326, "EPSG:32614": 32614, "32614": 32614, "urn:ogc:def:crs:EPSG::6372": 6372, "CRS84": 4326} {
+		if code, err := ParseCRS(value); err != nil || code != want {
+			t.Errorf("%q: esperado %d, obtenido %d (%v)", value, want, code, err)
+		}
+	}
+	if _, err := ParseCRS("EPSG:27700"); !errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("se esperaba rechazar un CRS no soportado, obtenido %v", err)
+	}
+
+	// Empire State Building en UTM 18N según la referencia de NGA
+	x, y, err := FromWGS84(32618, 40.7484, -73.9857)
+	if err != nil || math.Abs(x-585628.4) > 0.5 || math.Abs(y-4511322.4) > 0.5 {
+		t.Errorf("UTM inesperado: %v, %v (%v)", x, y, err)
+	}
+	// El origen de la cónica de Lambert de INEGI cae en el falso este
+	if x, y, _ := FromWGS84(6372, 12, -102); math.Abs(x-2500000) > 1e-6 || math.Abs(y) > 1e-6 {
+		t.Errorf("origen LCC inesperado: %v, %v", x, y)
+	}
+	for _, code := range []int{32614, 32714, 6369, 6372, 3857} {
+		x, y, err := FromWGS84(code, 19.4326, -99.1332)
+		if err != nil {
+			t.Fatalf("EPSG:%d: error inesperado: %v", code, err)
+		}
+		lat, lng, err := ToWGS84(code, x, y)
+		if err != nil || math.Abs(lat-19.4326) > 1e-8 || math.Abs(lng+99.1332) > 1e-8 {
+			t.Errorf("EPSG:%d: ida y vuelta inesperada: %v, %v (%v)", code, lat, lng, err)
+		}
+	}
+
+}
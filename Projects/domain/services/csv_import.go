@@ -0,0 +1,185 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// csvImportFields son los campos del proyecto que se pueden importar, con otros encabezados frecuentes
+// en hojas de cálculo además de la clave y los encabezados de la exportación
+var csvImportFields = []struct {
+	key      string
+	required bool
+	aliases  []string
+}{
+	{"nombre", true, []string{"nombreProyecto", "nombre del proyecto", "proyecto", "project", "project name"}},
+	{"fecha", true, []string{"fecha del levantamiento", "survey date"}},
+	{"categoria", true, []string{"tipo", "type"}},
+	{"descripcion", false, []string{"notas", "notes"}},
+	{"lat", false, []string{"latitude"}},
+	{"lng", false, []string{"lon", "long", "longitude"}},
+	{"user_id", false, []string{"userId"}},
+	{"img", false, []string{"imagen", "image", "foto"}},
+}
+
+// csvDateLayouts son los formatos de fecha aceptados además de ISO-8601: el de la exportación CSV y el
+// día/mes/año de las hojas de cálculo en español
+var csvDateLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"02/01/2006 15:04:05",
+	"02/01/2006 15:04",
+	"02/01/2006",
+}
+
+// CSVColumns indica en qué columna del CSV está cada campo del proyecto
+type CSVColumns map[string]int
+
+// ResolveCSVColumns relaciona los campos del proyecto con las columnas del encabezado. mapping tiene
+// prioridad (campo -> encabezado); los demás campos se buscan por su clave, sus encabezados de
+// exportación en español o inglés y otros nombres frecuentes, sin distinguir acentos ni mayúsculas
+func ResolveCSVColumns(header []string, mapping map[string]string) (CSVColumns, error) {
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		slug := Slugify(name)
+		if _, ok := positions[slug]; !ok && slug != "" {
+			positions[slug] = i
+		}
+	}
+
+	columns := make(CSVColumns)
+	for field, name := range mapping {
+		field = strings.ToLower(strings.TrimSpace(field))
+		if !isCSVImportField(field) {
+			return nil, fmt.Errorf("%w: el campo %q del mapeo no se puede importar", entities.ErrInvalidInput, field)
+		}
+		i, ok := positions[Slugify(name)]
+		if !ok {
+			return nil, fmt.Errorf("%w: el CSV no tiene la columna %q indicada para %s", entities.ErrInvalidInput, name, field)
+		}
+		columns[field] = i
+	}
+
+	for _, field := range csvImportFields {
+		if _, ok := columns[field.key]; ok {
+			continue
+		}
+		names := append([]string{field.key}, field.aliases...)
+		if column, ok := findProjectColumn(field.key); ok {
+			names = append(names, column.HeaderES, column.HeaderEN)
+		}
+		for _, name := range names {
+			if i, ok := positions[Slugify(name)]; ok {
+				columns[field.key] = i
+				break
+			}
+		}
+		if _, ok := columns[field.key]; !ok && field.required {
+			return nil, fmt.Errorf("%w: no se encontró la columna de %s; indíquela en el mapeo", entities.ErrInvalidInput, field.key)
+		}
+	}
+	return columns, nil
+}
+
+func isCSVImportField(key string) bool {
+	for _, field := range csvImportFields {
+		if field.key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// CSVRecordToProject convierte una fila en proyecto. Las fechas sin zona horaria se interpretan en loc
+// y userId se usa si la fila no trae el suyo. La categoría se resuelve contra el catálogo aparte
+func CSVRecordToProject(record []string, columns CSVColumns, defaultUserId int, loc *time.Location) (entities.Project, error) {
+	value := func(field string) string {
+		i, ok := columns[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	project := entities.Project{
+		NombreProyecto: value("nombre"),
+		Categoria:      value("categoria"),
+		Descripcion:    value("descripcion"),
+		Img:            value("img"),
+		UserId:         defaultUserId,
+	}
+
+	fecha, err := ParseCSVDate(value("fecha"), loc)
+	if err != nil {
+		return project, err
+	}
+	project.Fecha = fecha
+
+	if project.Lat, err = parseCSVNumber(value("lat"), "lat"); err != nil {
+		return project, err
+	}
+	if project.Lng, err = parseCSVNumber(value("lng"), "lng"); err != nil {
+		return project, err
+	}
+	if err := ValidateCoordinates(project.Lat, project.Lng); err != nil {
+		return project, err
+	}
+
+	if userId := value("user_id"); userId != "" {
+		id, err := strconv.Atoi(userId)
+		if err != nil || id <= 0 {
+			return project, fmt.Errorf("el user_id %q debe ser un número mayor a 0", userId)
+		}
+		project.UserId = id
+	}
+	return project, nil
+}
+
+// ParseCSVDate interpreta la fecha de una fila en ISO-8601 o en los formatos de hoja de cálculo
+// (AAAA-MM-DD HH:MM[:SS] y DD/MM/AAAA [HH:MM[:SS]])
+func ParseCSVDate(value string, loc *time.Location) (time.Time, error) {
+	fecha, err := entities.ParseProjectDate(value, loc)
+	if err == nil || value == "" {
+		return fecha, err
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	for _, layout := range csvDateLayouts {
+		if t, err := time.ParseInLocation(layout, strings.TrimSpace(value), loc); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("la fecha %q no es válida; use AAAA-MM-DD, AAAA-MM-DD HH:MM o DD/MM/AAAA", value)
+}
+
+// parseCSVNumber acepta punto o coma decimal; vacío equivale a 0
+func parseCSVNumber(value, field string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if !strings.Contains(value, ".") {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s %q no es un número válido", field, value)
+	}
+	return number, nil
+}
+
+// DetectCSVDelimiter elige el separador más frecuente de la línea de encabezado entre coma, punto y
+// coma y tab
+func DetectCSVDelimiter(headerLine string) rune {
+	delimiter, best := ',', strings.Count(headerLine, ",")
+	for _, candidate := range []rune{';', '\t'} {
+		if count := strings.Count(headerLine, string(candidate)); count > best {
+			delimiter, best = candidate, count
+		}
+	}
+	return delimiter
+}
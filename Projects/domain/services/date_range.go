@@ -0,0 +1,122 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// maxRelativeRangeDays limita los rangos relativos (last) a unos diez años
+const maxRelativeRangeDays = 3660
+
+// ResolveDateRange convierte los parámetros de un rango de fechas en un intervalo [From, To) en UTC.
+// Solo se admite una forma a la vez: from/to, last, month o year. Los días se interpretan en loc
+// y now es la referencia de los rangos relativos
+func ResolveDateRange(params entities.DateRangeParams, now time.Time, loc *time.Location) (entities.DateRange, error) {
+	var dateRange entities.DateRange
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	forms := 0
+	for _, set := range []bool{params.From != "" || params.To != "", params.Last != "", params.Month != "", params.Year != ""} {
+		if set {
+			forms++
+		}
+	}
+	if forms > 1 {
+		return dateRange, fmt.Errorf("%w: from/to, last, month y year no se pueden combinar", entities.ErrInvalidFilter)
+	}
+
+	switch {
+	case params.Last != "":
+		// El rango termina al final de hoy y retrocede n unidades de calendario
+		dateRange.To = entities.StartOfDay(now, loc).AddDate(0, 0, 1)
+		from, err := relativeRangeStart(params.Last, dateRange.To)
+		if err != nil {
+			return dateRange, err
+		}
+		dateRange.From = from
+
+	case params.Month != "":
+		month, err := time.ParseInLocation("2006-01", params.Month, loc)
+		if err != nil {
+			return dateRange, fmt.Errorf("%w: month debe tener formato AAAA-MM", entities.ErrInvalidFilter)
+		}
+		dateRange.From = month
+		dateRange.To = month.AddDate(0, 1, 0)
+
+	case params.Year != "":
+		year, err := strconv.Atoi(params.Year)
+		if err != nil || year < 1900 || year > 9999 {
+			return dateRange, fmt.Errorf("%w: year debe ser un año de cuatro dígitos", entities.ErrInvalidFilter)
+		}
+		dateRange.From = time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+		dateRange.To = dateRange.From.AddDate(1, 0, 0)
+
+	default:
+		// from y to son días completos; to se incluye entero
+		if params.From != "" {
+			from, err := time.ParseInLocation(entities.DateLayout, params.From, loc)
+			if err != nil {
+				return dateRange, fmt.Errorf("%w: la fecha %q debe tener formato AAAA-MM-DD", entities.ErrInvalidFilter, params.From)
+			}
+			dateRange.From = from
+		}
+		if params.To != "" {
+			to, err := time.ParseInLocation(entities.DateLayout, params.To, loc)
+			if err != nil {
+				return dateRange, fmt.Errorf("%w: la fecha %q debe tener formato AAAA-MM-DD", entities.ErrInvalidFilter, params.To)
+			}
+			dateRange.To = to.AddDate(0, 0, 1)
+		}
+	}
+
+	if !dateRange.From.IsZero() && !dateRange.To.IsZero() && !dateRange.From.Before(dateRange.To) {
+		return dateRange, fmt.Errorf("%w: la fecha inicial es posterior a la final", entities.ErrInvalidFilter)
+	}
+
+	if !dateRange.From.IsZero() {
+		dateRange.From = dateRange.From.UTC()
+	}
+	if !dateRange.To.IsZero() {
+		dateRange.To = dateRange.To.UTC()
+	}
+	return dateRange, nil
+}
+
+// relativeRangeStart interpreta un rango relativo como 30d, 4w, 6m o 1y y devuelve su inicio contando
+// hacia atrás desde to. Meses y años son de calendario, no de 30 o 365 días
+func relativeRangeStart(value string, to time.Time) (time.Time, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	invalid := fmt.Errorf("%w: last debe tener el formato <número><d|w|m|y>, por ejemplo 30d", entities.ErrInvalidFilter)
+	if len(value) < 2 {
+		return time.Time{}, invalid
+	}
+
+	n, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil || n <= 0 || n > maxRelativeRangeDays {
+		return time.Time{}, invalid
+	}
+
+	var from time.Time
+	switch value[len(value)-1] {
+	case 'd':
+		from = to.AddDate(0, 0, -n)
+	case 'w':
+		from = to.AddDate(0, 0, -7*n)
+	case 'm':
+		from = to.AddDate(0, -n, 0)
+	case 'y':
+		from = to.AddDate(-n, 0, 0)
+	default:
+		return time.Time{}, invalid
+	}
+	if from.Before(to.AddDate(0, 0, -maxRelativeRangeDays)) {
+		return time.Time{}, fmt.Errorf("%w: last no puede superar %d días", entities.ErrInvalidFilter, maxRelativeRangeDays)
+	}
+	return from, nil
+}
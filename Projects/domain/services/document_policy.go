@@ -0,0 +1,71 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// DefaultMaxDocumentSize es el tamaño máximo de un adjunto si no se configura otro (20 MB)
+const DefaultMaxDocumentSize int64 = 20 << 20
+
+// maxDocumentNameLength coincide con el tamaño de la columna file_name
+const maxDocumentNameLength = 255
+
+// documentType describe un tipo de adjunto permitido: su MIME y el tipo que debe detectarse en el contenido
+type documentType struct {
+	contentType string
+	sniffed     string
+}
+
+// allowedDocumentTypes es la lista de extensiones permitidas. DXF y CSV son texto, por lo que su
+// contenido debe detectarse como text/plain; así se rechaza un binario renombrado
+var allowedDocumentTypes = map[string]documentType{
+	".pdf": {contentType: "application/pdf", sniffed: "application/pdf"},
+	".dxf": {contentType: "image/vnd.dxf", sniffed: "text/plain"},
+	".csv": {contentType: "text/csv", sniffed: "text/plain"},
+}
+
+// ValidateDocument comprueba el nombre, el tamaño y el tipo de un adjunto a partir de su extensión y
+// de los primeros bytes del contenido (head). Devuelve el MIME con el que se guarda el archivo
+func ValidateDocument(fileName string, size int64, head []byte, maxSize int64) (string, error) {
+	if fileName == "" || utf8.RuneCountInString(fileName) > maxDocumentNameLength {
+		return "", fmt.Errorf("%w: el nombre del archivo debe tener entre 1 y %d caracteres", entities.ErrInvalidInput, maxDocumentNameLength)
+	}
+	if size <= 0 {
+		return "", fmt.Errorf("%w: el archivo está vacío", entities.ErrInvalidInput)
+	}
+	if size > maxSize {
+		return "", fmt.Errorf("%w: el archivo supera el tamaño máximo de %d MB", entities.ErrInvalidInput, maxSize>>20)
+	}
+
+	ext := strings.ToLower(filepath.Ext(fileName))
+	docType, ok := allowedDocumentTypes[ext]
+	if !ok {
+		return "", fmt.Errorf("%w: tipo de archivo %q no permitido (se aceptan PDF, DXF y CSV)", entities.ErrInvalidInput, ext)
+	}
+	sniffed := http.DetectContentType(head)
+	if !strings.HasPrefix(sniffed, docType.sniffed) {
+		return "", fmt.Errorf("%w: el contenido del archivo no corresponde a un %s", entities.ErrInvalidInput, strings.ToUpper(strings.TrimPrefix(ext, ".")))
+	}
+	return docType.contentType, nil
+}
+
+// SafeFileName deja solo el nombre base del archivo, sin rutas ni caracteres de control
+func SafeFileName(fileName string) string {
+	fileName = filepath.Base(strings.ReplaceAll(fileName, `\`, "/"))
+	fileName = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, fileName)
+	if fileName == "." || fileName == "/" {
+		return ""
+	}
+	return strings.TrimSpace(fileName)
+}
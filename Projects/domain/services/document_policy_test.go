@@ -0,0 +1,40 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+func TestValidateDocument(t *testing.T) {
+	pdf := []byte("%PDF-1.7\n%âãÏÓ\n1 0 obj")
+	if contentType, err := ValidateDocument("plano.PDF", 1024, pdf, DefaultMaxDocumentSize); err != nil || contentType != "application/pdf" {
+		t.Errorf("PDF válido rechazado: %q, %v", contentType, err)
+	}
+	if contentType, err := ValidateDocument("puntos.csv", 20, []byte("id,lat,lng\n1,19.4,-99.1"), DefaultMaxDocumentSize); err != nil || contentType != "text/csv" {
+		t.Errorf("CSV válido rechazado: %q, %v", contentType, err)
+	}
+
+	cases := []struct {
+		name     string
+		fileName string
+		size     int64
+		head     []byte
+	}{
+		{"extensión no permitida", "virus.exe", 10, []byte("MZ")},
+		{"binario renombrado", "datos.csv", 10, pdf},
+		{"PDF falso", "plano.pdf", 10, []byte("hola")},
+		{"demasiado grande", "plano.pdf", DefaultMaxDocumentSize + 1, pdf},
+		{"vacío", "plano.pdf", 0, nil},
+	}
+	for _, tc := range cases {
+		if _, err := ValidateDocument(tc.fileName, tc.size, tc.head, DefaultMaxDocumentSize); !errors.Is(err, entities.ErrInvalidInput) {
+			t.Errorf("%s: se esperaba ErrInvalidInput, obtenido %v", tc.name, err)
+		}
+	}
+
+	if got := SafeFileName(`C:\planos\..\norte.dxf`); got != "norte.dxf" {
+		t.Errorf("nombre de archivo inesperado: %q", got)
+	}
+}
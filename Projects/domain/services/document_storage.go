@@ -0,0 +1,11 @@
+package services
+
+import "io"
+
+// IDocumentStorage es el puerto de almacenamiento de los documentos adjuntos. Save devuelve la
+// ubicación con la que después se abre o elimina el archivo; su formato depende del adaptador
+type IDocumentStorage interface {
+	Save(key string, content io.Reader, size int64, contentType string) (string, error)
+	Open(location string) (io.ReadCloser, error)
+	Delete(location string) error
+}
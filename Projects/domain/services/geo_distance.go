@@ -0,0 +1,116 @@
+package services
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// EarthRadiusMeters es el radio medio de la Tierra (IUGG) usado en los cálculos esféricos
+const EarthRadiusMeters = 6371008.8
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func toDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+// HaversineDistance calcula la distancia en metros entre dos puntos WGS84
+func HaversineDistance(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := toRadians(lat2 - lat1)
+	dLng := toRadians(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// GeodesicDistance calcula la distancia en metros entre dos puntos sobre el elipsoide WGS84 con la
+// fórmula inversa de Vincenty. Para puntos casi antípodas, donde la iteración no converge, usa HaversineDistance
+func GeodesicDistance(lat1, lng1, lat2, lng2 float64) float64 {
+	a, f := wgs84Ellipsoid.a, wgs84Ellipsoid.f
+	b := a * (1 - f)
+	l := toRadians(lng2 - lng1)
+	u1 := math.Atan((1 - f) * math.Tan(toRadians(lat1)))
+	u2 := math.Atan((1 - f) * math.Tan(toRadians(lat2)))
+	sinU1, cosU1 := math.Sin(u1), math.Cos(u1)
+	sinU2, cosU2 := math.Sin(u2), math.Cos(u2)
+
+	lambda := l
+	var sinSigma, cosSigma, sigma, cos2Alpha, cos2SigmaM float64
+	converged := false
+	for i := 0; i < 200; i++ {
+		sinLambda, cosLambda := math.Sin(lambda), math.Cos(lambda)
+		sinSigma = math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			return 0
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cos2Alpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0
+		if cos2Alpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cos2Alpha
+		}
+		c := f / 16 * cos2Alpha * (4 + f*(4-3*cos2Alpha))
+		previous := lambda
+		lambda = l + (1-c)*f*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-previous) < 1e-12 {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return HaversineDistance(lat1, lng1, lat2, lng2)
+	}
+
+	uSq := cos2Alpha * (a*a - b*b) / (b * b)
+	bigA := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	bigB := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := bigB * sinSigma * (cos2SigmaM + bigB/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		bigB/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+	return b * bigA * (sigma - deltaSigma)
+}
+
+// BoundingBoxAround calcula el rectángulo que contiene el círculo de radio dado alrededor de un punto.
+// Si el círculo alcanza un polo se cubren todas las longitudes
+func BoundingBoxAround(lat, lng, radiusMeters float64) entities.BoundingBox {
+	angular := toDegrees(radiusMeters / EarthRadiusMeters)
+	minLat, maxLat := lat-angular, lat+angular
+	if minLat <= -90 || maxLat >= 90 {
+		return entities.BoundingBox{
+			MinLat: math.Max(minLat, -90),
+			MaxLat: math.Min(maxLat, 90),
+			MinLng: -180,
+			MaxLng: 180,
+		}
+	}
+
+	deltaLng := toDegrees(math.Asin(math.Sin(radiusMeters/EarthRadiusMeters) / math.Cos(toRadians(lat))))
+	minLng, maxLng := lng-deltaLng, lng+deltaLng
+	if deltaLng >= 180 {
+		minLng, maxLng = -180, 180
+	}
+	// Normaliza al rango [-180, 180]; si cruza el antimeridiano MinLng queda mayor que MaxLng
+	if minLng < -180 {
+		minLng += 360
+	}
+	if maxLng > 180 {
+		maxLng -= 360
+	}
+	return entities.BoundingBox{MinLat: minLat, MaxLat: maxLat, MinLng: minLng, MaxLng: maxLng}
+}
+
+// ValidateCoordinates comprueba que latitud y longitud estén en los rangos de WGS84
+func ValidateCoordinates(lat, lng float64) error {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return fmt.Errorf("latitud %v fuera del rango [-90, 90]", lat)
+	}
+	if math.IsNaN(lng) || lng < -180 || lng > 180 {
+		return fmt.Errorf("longitud %v fuera del rango [-180, 180]", lng)
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package services
+
+import "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+
+// IGeocoder es el puerto de geocodificación inversa: devuelve la división administrativa de un punto
+// WGS84. Si el punto no cae en ninguna devuelve un error que envuelve entities.ErrNotFound
+type IGeocoder interface {
+	ReverseGeocode(lat, lng float64) (*entities.ProjectAddress, error)
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// GeocodingJob representa la geocodificación pendiente de la ubicación de un proyecto
+type GeocodingJob struct {
+	ProjectId int
+	Lat       float64
+	Lng       float64
+}
+
+// AddressStore guarda la dirección obtenida para un proyecto
+type AddressStore func(projectId int, address entities.ProjectAddress) error
+
+// GeocodingWorkerService geocodifica en segundo plano las ubicaciones de los proyectos creados o
+// movidos, para que el geocodificador externo no retrase las respuestas de la API
+type GeocodingWorkerService struct {
+	geocoder   IGeocoder
+	store      AddressStore
+	jobQueue   chan GeocodingJob
+	shutdown   chan struct{}
+	wg         sync.WaitGroup
+	mu         sync.RWMutex // Protege el estado de shutdown
+	isShutdown bool
+}
+
+// NewGeocodingWorkerService crea el servicio de geocodificación. Con un solo worker se respetan los
+// límites de uso de servicios públicos como Nominatim
+func NewGeocodingWorkerService(geocoder IGeocoder, store AddressStore, numWorkers int, queueSize int) *GeocodingWorkerService {
+	service := &GeocodingWorkerService{
+		geocoder: geocoder,
+		store:    store,
+		jobQueue: make(chan GeocodingJob, queueSize),
+		shutdown: make(chan struct{}),
+	}
+
+	for i := 0; i < numWorkers; i++ {
+		service.wg.Add(1)
+		go service.worker(i)
+	}
+
+	log.Printf("INFO: GeocodingWorkerService iniciado con %d workers", numWorkers)
+	return service
+}
+
+// worker es el loop principal de cada worker
+func (s *GeocodingWorkerService) worker(id int) {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case job, ok := <-s.jobQueue:
+			if !ok {
+				return
+			}
+			s.processJob(id, job)
+		case <-s.shutdown:
+			log.Printf("INFO: Worker de geocodificación %d recibió señal de shutdown", id)
+			return
+		}
+	}
+}
+
+// processJob geocodifica un proyecto con reintentos. Un punto fuera de toda división administrativa
+// (por ejemplo en el mar) se guarda como dirección vacía para no volver a consultarlo
+func (s *GeocodingWorkerService) processJob(workerID int, job GeocodingJob) {
+	maxAttempts := 3
+	delays := []time.Duration{1 * time.Second, 2 * time.Second}
+
+	var address *entities.ProjectAddress
+	var err error
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := delays[attempt-1]
+			log.Printf("INFO: Worker de geocodificación %d reintentando proyecto %d (intento %d/%d) tras %v", workerID, job.ProjectId, attempt+1, maxAttempts, delay)
+			time.Sleep(delay)
+		}
+
+		address, err = s.geocoder.ReverseGeocode(job.Lat, job.Lng)
+		if err == nil || errors.Is(err, entities.ErrNotFound) {
+			break
+		}
+		log.Printf("WARNING: Worker de geocodificación %d falló intento %d/%d para el proyecto %d: %v", workerID, attempt+1, maxAttempts, job.ProjectId, err)
+	}
+
+	if errors.Is(err, entities.ErrNotFound) {
+		address, err = &entities.ProjectAddress{}, nil
+	}
+	if err != nil {
+		log.Printf("ERROR: No se pudo geocodificar el proyecto %d: %v", job.ProjectId, err)
+		return
+	}
+	if address.GeocodedAt.IsZero() {
+		address.GeocodedAt = time.Now().UTC()
+	}
+
+	if err := s.store(job.ProjectId, *address); err != nil {
+		log.Printf("ERROR: No se pudo guardar la dirección del proyecto %d: %v", job.ProjectId, err)
+		return
+	}
+	log.Printf("SUCCESS: Proyecto %d geocodificado: %s, %s, %s", job.ProjectId, address.Municipality, address.State, address.Country)
+}
+
+// SubmitGeocodingJob encola la geocodificación de un proyecto sin esperar el resultado
+func (s *GeocodingWorkerService) SubmitGeocodingJob(projectId int, lat, lng float64) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.isShutdown {
+		return fmt.Errorf("servicio en shutdown, no se aceptan nuevos trabajos")
+	}
+
+	select {
+	case s.jobQueue <- GeocodingJob{ProjectId: projectId, Lat: lat, Lng: lng}:
+		return nil
+	default:
+		return fmt.Errorf("cola de geocodificación llena, intente más tarde")
+	}
+}
+
+// Shutdown cierra el servicio de forma ordenada
+func (s *GeocodingWorkerService) Shutdown() {
+	s.mu.Lock()
+	if s.isShutdown {
+		s.mu.Unlock()
+		return
+	}
+	s.isShutdown = true
+	s.mu.Unlock()
+
+	log.Println("INFO: Iniciando shutdown de GeocodingWorkerService...")
+	close(s.shutdown)
+	close(s.jobQueue)
+	s.wg.Wait()
+	log.Println("INFO: GeocodingWorkerService shutdown completado")
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// ProjectToFeature convierte un proyecto en un Feature GeoJSON. Si el proyecto tiene un área
+// levantada se usa su polígono o línea; si no, un Point en Lat/Lng
+func ProjectToFeature(project entities.Project) entities.GeoJSONFeature {
+	geometry := &entities.GeoJSONGeometry{Type: "Point"}
+	geometry.Coordinates, _ = json.Marshal([]float64{project.Lng, project.Lat})
+	if project.Geometry != nil {
+		raw, _ := json.Marshal(project.Geometry)
+		_ = json.Unmarshal(raw, geometry)
+	}
+	properties := map[string]interface{}{
+		"id":             project.Id,
+		"nombreProyecto": project.NombreProyecto,
+		"fecha":          project.Fecha,
+		"categoria":      project.Categoria,
+		"descripcion":    project.Descripcion,
+		"img":            project.Img,
+		"userId":         project.UserId,
+		"pointCount":     project.PointCount,
+		"areaM2":         project.AreaM2,
+		"perimeterM":     project.PerimeterM,
+		"status":         project.Status,
+	}
+	if project.Address != nil {
+		properties["municipality"] = project.Address.Municipality
+		properties["state"] = project.Address.State
+		properties["country"] = project.Address.Country
+	}
+	return entities.GeoJSONFeature{
+		Type:       "Feature",
+		Id:         project.Id,
+		Geometry:   geometry,
+		Properties: properties,
+	}
+}
+
+// FeatureToProject convierte un Feature GeoJSON de tipo Point, Polygon o LineString en un proyecto.
+// En los dos últimos la geometría queda en Geometry y Lat/Lng se calculan al validarla.
+// Si el Feature no trae userId en sus propiedades se usa defaultUserId; las fechas sin zona horaria se interpretan en loc
+func FeatureToProject(feature entities.GeoJSONFeature, defaultUserId int, loc *time.Location) (entities.Project, error) {
+	var project entities.Project
+
+	if feature.Type != "Feature" {
+		return project, fmt.Errorf("se esperaba type Feature, se recibió %q", feature.Type)
+	}
+	if feature.Geometry == nil {
+		return project, fmt.Errorf("el Feature no tiene geometría")
+	}
+	switch feature.Geometry.Type {
+	case "Point":
+		var coordinates []float64
+		if err := json.Unmarshal(feature.Geometry.Coordinates, &coordinates); err != nil || len(coordinates) < 2 || len(coordinates) > 3 {
+			return project, fmt.Errorf("las coordenadas del Point deben ser [lng, lat] o [lng, lat, altitud]")
+		}
+		project.Lng, project.Lat = coordinates[0], coordinates[1]
+		if err := ValidateCoordinates(project.Lat, project.Lng); err != nil {
+			return project, err
+		}
+	case entities.GeometryPolygon, entities.GeometryLineString:
+		raw, _ := json.Marshal(feature.Geometry)
+		project.Geometry = &entities.ProjectGeometry{}
+		if err := json.Unmarshal(raw, project.Geometry); err != nil {
+			return project, err
+		}
+	default:
+		return project, fmt.Errorf("la geometría debe ser de tipo Point, Polygon o LineString")
+	}
+
+	props := feature.Properties
+	project.NombreProyecto = stringProperty(props, "nombreProyecto")
+	fecha, err := entities.ParseProjectDate(stringProperty(props, "fecha"), loc)
+	if err != nil {
+		return project, err
+	}
+	project.Fecha = fecha
+	project.Categoria = stringProperty(props, "categoria")
+	project.Descripcion = stringProperty(props, "descripcion")
+	project.Img = stringProperty(props, "img")
+
+	project.UserId = defaultUserId
+	if raw, ok := props["userId"]; ok && raw != nil {
+		userId, err := intProperty(raw)
+		if err != nil {
+			return project, fmt.Errorf("la propiedad userId debe ser un número entero")
+		}
+		project.UserId = userId
+	}
+
+	return project, nil
+}
+
+func stringProperty(props map[string]interface{}, key string) string {
+	switch v := props[key].(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case nil:
+		return ""
+	default:
+		return strings.TrimSpace(fmt.Sprint(v))
+	}
+}
+
+func intProperty(raw interface{}) (int, error) {
+	switch v := raw.(type) {
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, fmt.Errorf("no es entero")
+		}
+		return int(v), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(v))
+	default:
+		return 0, fmt.Errorf("tipo no soportado")
+	}
+}
@@ -0,0 +1,471 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// MaxGeometryVertices limita el tamaño de la geometría; la validación de autointersección compara
+// todos los segmentos entre sí
+const MaxGeometryVertices = 5000
+
+// GeometryMetrics son las medidas que el servidor calcula a partir de la geometría
+type GeometryMetrics struct {
+	// AreaM2 es el área sobre el elipsoide WGS84 en metros cuadrados; 0 en un LineString
+	AreaM2 float64
+	// PerimeterM es el perímetro del anillo exterior o la longitud de la línea, en metros
+	PerimeterM  float64
+	CentroidLat float64
+	CentroidLng float64
+}
+
+// ParseGeometry interpreta una geometría GeoJSON (objeto geometry o Feature) o un texto WKT
+// (POLYGON o LINESTRING) con coordenadas WGS84. La altitud se descarta
+func ParseGeometry(input string) (*entities.ProjectGeometry, error) {
+	return ParseGeometryInCRS(input, entities.CRSWGS84)
+}
+
+// ParseGeometryInCRS interpreta una geometría cuyas coordenadas están en el CRS indicado y la reproyecta
+// a WGS84. Un prefijo SRID=n; en el WKT tiene prioridad sobre crs
+func ParseGeometryInCRS(input string, crs int) (*entities.ProjectGeometry, error) {
+	text := strings.TrimSpace(input)
+	if text == "" {
+		return nil, fmt.Errorf("%w: la geometría está vacía", entities.ErrInvalidInput)
+	}
+
+	var geometry *entities.ProjectGeometry
+	var err error
+	if strings.HasPrefix(text, "{") {
+		geometry, err = parseGeoJSONGeometry(text)
+	} else {
+		geometry, crs, err = parseWKTGeometry(text, crs)
+	}
+	if err != nil || crs == entities.CRSWGS84 {
+		return geometry, err
+	}
+	return ReprojectGeometry(geometry, crs, entities.CRSWGS84)
+}
+
+func parseGeoJSONGeometry(text string) (*entities.ProjectGeometry, error) {
+	var feature entities.GeoJSONFeature
+	if err := json.Unmarshal([]byte(text), &feature); err != nil {
+		return nil, fmt.Errorf("%w: GeoJSON inválido: %v", entities.ErrInvalidInput, err)
+	}
+	raw := []byte(text)
+	if feature.Type == "Feature" {
+		if feature.Geometry == nil {
+			return nil, fmt.Errorf("%w: el Feature no tiene geometría", entities.ErrInvalidInput)
+		}
+		raw, _ = json.Marshal(feature.Geometry)
+	}
+
+	var geometry entities.ProjectGeometry
+	if err := json.Unmarshal(raw, &geometry); err != nil {
+		return nil, fmt.Errorf("%w: %v", entities.ErrInvalidInput, err)
+	}
+	return &geometry, nil
+}
+
+// parseWKTGeometry devuelve la geometría y el CRS de sus coordenadas: el del prefijo SRID o crs si no lo trae
+func parseWKTGeometry(text string, crs int) (*entities.ProjectGeometry, int, error) {
+	if strings.HasPrefix(strings.ToUpper(text), "SRID=") {
+		separator := strings.Index(text, ";")
+		if separator < 0 {
+			return nil, 0, fmt.Errorf("%w: falta ';' después del SRID", entities.ErrInvalidInput)
+		}
+		srid, err := ParseCRS(text[len("SRID="):separator])
+		if err != nil {
+			return nil, 0, err
+		}
+		crs = srid
+		text = strings.TrimSpace(text[separator+1:])
+	}
+
+	var geometry entities.ProjectGeometry
+	upper := strings.ToUpper(text)
+	switch {
+	case strings.HasPrefix(upper, "POLYGON"):
+		geometry.Type = entities.GeometryPolygon
+		text = text[len("POLYGON"):]
+	case strings.HasPrefix(upper, "LINESTRING"):
+		geometry.Type = entities.GeometryLineString
+		text = text[len("LINESTRING"):]
+	default:
+		return nil, 0, fmt.Errorf("%w: WKT no soportado; use POLYGON o LINESTRING", entities.ErrInvalidInput)
+	}
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(strings.ToUpper(text), "Z") {
+		text = strings.TrimSpace(text[1:])
+	}
+
+	body, ok := unwrapParentheses(text)
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: WKT mal formado", entities.ErrInvalidInput)
+	}
+	if geometry.Type == entities.GeometryLineString {
+		line, err := parseWKTPositions(body)
+		if err != nil {
+			return nil, 0, err
+		}
+		geometry.Coordinates = [][]entities.Position{line}
+		return &geometry, crs, nil
+	}
+
+	// Los anillos del polígono vienen como (x y, ...), (x y, ...)
+	for rest := strings.TrimSpace(body); rest != ""; {
+		end := strings.Index(rest, ")")
+		if !strings.HasPrefix(rest, "(") || end < 0 {
+			return nil, 0, fmt.Errorf("%w: WKT mal formado", entities.ErrInvalidInput)
+		}
+		ring, err := parseWKTPositions(rest[1:end])
+		if err != nil {
+			return nil, 0, err
+		}
+		geometry.Coordinates = append(geometry.Coordinates, ring)
+
+		rest = strings.TrimSpace(rest[end+1:])
+		if rest != "" {
+			if !strings.HasPrefix(rest, ",") {
+				return nil, 0, fmt.Errorf("%w: WKT mal formado", entities.ErrInvalidInput)
+			}
+			rest = strings.TrimSpace(rest[1:])
+		}
+	}
+	return &geometry, crs, nil
+}
+
+// unwrapParentheses quita el par de paréntesis que envuelve todo el texto
+func unwrapParentheses(text string) (string, bool) {
+	if len(text) < 2 || text[0] != '(' || text[len(text)-1] != ')' {
+		return "", false
+	}
+	return text[1 : len(text)-1], true
+}
+
+func parseWKTPositions(text string) ([]entities.Position, error) {
+	parts := strings.Split(text, ",")
+	positions := make([]entities.Position, 0, len(parts))
+	for _, part := range parts {
+		fields := strings.Fields(part)
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("%w: cada vértice WKT debe ser 'lng lat' o 'lng lat altitud'", entities.ErrInvalidInput)
+		}
+		lng, errLng := strconv.ParseFloat(fields[0], 64)
+		lat, errLat := strconv.ParseFloat(fields[1], 64)
+		if errLng != nil || errLat != nil {
+			return nil, fmt.Errorf("%w: coordenada WKT inválida %q", entities.ErrInvalidInput, strings.TrimSpace(part))
+		}
+		positions = append(positions, entities.Position{lng, lat})
+	}
+	return positions, nil
+}
+
+// ValidateGeometry elimina los vértices consecutivos repetidos y verifica que la geometría sea válida:
+// coordenadas en rango, anillos cerrados de al menos cuatro vértices, sin autointersecciones y con los
+// huecos dentro del anillo exterior. No se admiten geometrías que crucen el antimeridiano
+func ValidateGeometry(geometry *entities.ProjectGeometry) error {
+	if geometry == nil {
+		return fmt.Errorf("%w: la geometría es obligatoria", entities.ErrInvalidInput)
+	}
+	switch geometry.Type {
+	case entities.GeometryPolygon:
+		if len(geometry.Coordinates) == 0 {
+			return fmt.Errorf("%w: el polígono necesita un anillo exterior", entities.ErrInvalidInput)
+		}
+	case entities.GeometryLineString:
+		if len(geometry.Coordinates) != 1 {
+			return fmt.Errorf("%w: el LineString debe tener una sola línea", entities.ErrInvalidInput)
+		}
+	default:
+		return fmt.Errorf("%w: tipo de geometría %q no soportado; use Polygon o LineString", entities.ErrInvalidInput, geometry.Type)
+	}
+
+	vertices := 0
+	for i, ring := range geometry.Coordinates {
+		ring = dedupePositions(ring)
+		geometry.Coordinates[i] = ring
+		vertices += len(ring)
+
+		for j, position := range ring {
+			if err := ValidateCoordinates(position[1], position[0]); err != nil {
+				return fmt.Errorf("%w: %v", entities.ErrInvalidInput, err)
+			}
+			if j > 0 && math.Abs(position[0]-ring[j-1][0]) > 180 {
+				return fmt.Errorf("%w: no se admiten geometrías que crucen el antimeridiano", entities.ErrInvalidInput)
+			}
+		}
+	}
+	if vertices > MaxGeometryVertices {
+		return fmt.Errorf("%w: la geometría supera los %d vértices", entities.ErrInvalidInput, MaxGeometryVertices)
+	}
+
+	if geometry.Type == entities.GeometryLineString {
+		if len(geometry.Coordinates[0]) < 2 {
+			return fmt.Errorf("%w: la línea necesita al menos dos vértices distintos", entities.ErrInvalidInput)
+		}
+		return nil
+	}
+
+	for i, ring := range geometry.Coordinates {
+		name := "el anillo exterior"
+		if i > 0 {
+			name = fmt.Sprintf("el hueco %d", i)
+		}
+		if len(ring) < 2 || ring[0] != ring[len(ring)-1] {
+			return fmt.Errorf("%w: %s no está cerrado; el último vértice debe repetir el primero", entities.ErrInvalidInput, name)
+		}
+		if len(ring) < 4 {
+			return fmt.Errorf("%w: %s necesita al menos cuatro vértices", entities.ErrInvalidInput, name)
+		}
+		if planarRingArea(ring, ring[0]) == 0 {
+			return fmt.Errorf("%w: %s no encierra ningún área", entities.ErrInvalidInput, name)
+		}
+		if i > 0 && !PointInRing(ring[0], geometry.Coordinates[0]) {
+			return fmt.Errorf("%w: %s está fuera del anillo exterior", entities.ErrInvalidInput, name)
+		}
+	}
+	if polygonSelfIntersects(geometry.Coordinates) {
+		return fmt.Errorf("%w: el polígono se autointersecta", entities.ErrInvalidInput)
+	}
+	return nil
+}
+
+func dedupePositions(positions []entities.Position) []entities.Position {
+	result := make([]entities.Position, 0, len(positions))
+	for _, position := range positions {
+		if len(result) == 0 || result[len(result)-1] != position {
+			result = append(result, position)
+		}
+	}
+	return result
+}
+
+// geometrySegment es un lado de un anillo; index es su posición dentro del anillo
+type geometrySegment struct {
+	a, b        entities.Position
+	ring, index int
+}
+
+// polygonSelfIntersects compara todos los lados del polígono entre sí. Dos lados consecutivos de un
+// mismo anillo solo pueden compartir su vértice común
+func polygonSelfIntersects(rings [][]entities.Position) bool {
+	var segments []geometrySegment
+	sides := make([]int, len(rings))
+	for r, ring := range rings {
+		sides[r] = len(ring) - 1
+		for i := 0; i < len(ring)-1; i++ {
+			segments = append(segments, geometrySegment{a: ring[i], b: ring[i+1], ring: r, index: i})
+		}
+	}
+
+	for i := 0; i < len(segments); i++ {
+		for j := i + 1; j < len(segments); j++ {
+			s, t := segments[i], segments[j]
+			if !boxesOverlap(s, t) {
+				continue
+			}
+			if s.ring == t.ring {
+				// Los lados consecutivos comparten un vértice; solo hay problema si uno regresa sobre el otro
+				if t.index == s.index+1 {
+					if collinearOverlap(s.b, s.a, t.b) {
+						return true
+					}
+					continue
+				}
+				if s.index == 0 && t.index == sides[s.ring]-1 {
+					if collinearOverlap(s.a, s.b, t.a) {
+						return true
+					}
+					continue
+				}
+			}
+			if segmentsIntersect(s.a, s.b, t.a, t.b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// collinearOverlap indica si dos lados que comparten el vértice shared y terminan en p y q se superponen
+func collinearOverlap(shared, p, q entities.Position) bool {
+	return orientation(shared, p, q) == 0 && (onSegment(shared, q, p) || onSegment(shared, p, q))
+}
+
+func boxesOverlap(s, t geometrySegment) bool {
+	return math.Max(s.a[0], s.b[0]) >= math.Min(t.a[0], t.b[0]) &&
+		math.Max(t.a[0], t.b[0]) >= math.Min(s.a[0], s.b[0]) &&
+		math.Max(s.a[1], s.b[1]) >= math.Min(t.a[1], t.b[1]) &&
+		math.Max(t.a[1], t.b[1]) >= math.Min(s.a[1], s.b[1])
+}
+
+// orientation devuelve 1 si p, q, r giran en sentido antihorario, -1 si giran en sentido horario y 0 si son colineales
+func orientation(p, q, r entities.Position) int {
+	value := (q[0]-p[0])*(r[1]-p[1]) - (q[1]-p[1])*(r[0]-p[0])
+	switch {
+	case value > 0:
+		return 1
+	case value < 0:
+		return -1
+	}
+	return 0
+}
+
+// onSegment indica si q, colineal con p y r, está dentro del segmento pr
+func onSegment(p, q, r entities.Position) bool {
+	return q[0] <= math.Max(p[0], r[0]) && q[0] >= math.Min(p[0], r[0]) &&
+		q[1] <= math.Max(p[1], r[1]) && q[1] >= math.Min(p[1], r[1])
+}
+
+func segmentsIntersect(p1, p2, p3, p4 entities.Position) bool {
+	o1, o2 := orientation(p1, p2, p3), orientation(p1, p2, p4)
+	o3, o4 := orientation(p3, p4, p1), orientation(p3, p4, p2)
+	if o1 != o2 && o3 != o4 {
+		return true
+	}
+	return (o1 == 0 && onSegment(p1, p3, p2)) || (o2 == 0 && onSegment(p1, p4, p2)) ||
+		(o3 == 0 && onSegment(p3, p1, p4)) || (o4 == 0 && onSegment(p3, p2, p4))
+}
+
+// PointInRing aplica el algoritmo de ray casting sobre el plano lng/lat
+func PointInRing(point entities.Position, ring []entities.Position) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a[1] > point[1]) != (b[1] > point[1]) &&
+			point[0] < (b[0]-a[0])*(point[1]-a[1])/(b[1]-a[1])+a[0] {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// MeasureGeometry calcula el área, el perímetro y el centroide de una geometría ya validada.
+// El área de cada anillo se calcula sobre la esfera auténtica (de igual área que el elipsoide WGS84)
+// y se resta la de los huecos; las longitudes son geodésicas y el centroide se calcula en el plano
+// lng/lat, suficiente para parcelas
+func MeasureGeometry(geometry entities.ProjectGeometry) GeometryMetrics {
+	var metrics GeometryMetrics
+	if len(geometry.Coordinates) == 0 || len(geometry.Coordinates[0]) == 0 {
+		return metrics
+	}
+
+	if geometry.Type == entities.GeometryLineString {
+		line := geometry.Coordinates[0]
+		var sumLat, sumLng float64
+		for i := 0; i < len(line)-1; i++ {
+			length := GeodesicDistance(line[i][1], line[i][0], line[i+1][1], line[i+1][0])
+			metrics.PerimeterM += length
+			sumLng += length * (line[i][0] + line[i+1][0]) / 2
+			sumLat += length * (line[i][1] + line[i+1][1]) / 2
+		}
+		metrics.CentroidLng, metrics.CentroidLat = line[0][0], line[0][1]
+		if metrics.PerimeterM > 0 {
+			metrics.CentroidLng, metrics.CentroidLat = sumLng/metrics.PerimeterM, sumLat/metrics.PerimeterM
+		}
+		return metrics
+	}
+
+	outer := geometry.Coordinates[0]
+	for i := 0; i < len(outer)-1; i++ {
+		metrics.PerimeterM += GeodesicDistance(outer[i][1], outer[i][0], outer[i+1][1], outer[i+1][0])
+	}
+
+	// Las coordenadas se trasladan al primer vértice para reducir el error de redondeo
+	origin := outer[0]
+	var weight, sumX, sumY float64
+	for i, ring := range geometry.Coordinates {
+		area := sphericalRingArea(ring)
+		planar := math.Abs(planarRingArea(ring, origin))
+		x, y := planarRingCentroid(ring, origin)
+		sign := 1.0
+		if i > 0 {
+			sign = -1
+		}
+		metrics.AreaM2 += sign * area
+		weight += sign * planar
+		sumX += sign * planar * x
+		sumY += sign * planar * y
+	}
+	metrics.AreaM2 = math.Max(metrics.AreaM2, 0)
+	metrics.CentroidLng, metrics.CentroidLat = origin[0], origin[1]
+	if weight > 0 {
+		metrics.CentroidLng, metrics.CentroidLat = origin[0]+sumX/weight, origin[1]+sumY/weight
+	}
+	return metrics
+}
+
+// authalicLatitude convierte una latitud geodésica WGS84 en radianes a la latitud de la esfera auténtica,
+// que conserva las áreas del elipsoide
+func authalicLatitude(phi float64) float64 {
+	return math.Asin(math.Max(-1, math.Min(1, authalicQ(math.Sin(phi))/authalicQ(1))))
+}
+
+func authalicQ(sin float64) float64 {
+	e2 := wgs84Ellipsoid.f * (2 - wgs84Ellipsoid.f)
+	e := math.Sqrt(e2)
+	return (1 - e2) * (sin/(1-e2*sin*sin) - math.Log((1-e*sin)/(1+e*sin))/(2*e))
+}
+
+// sphericalRingArea es el área en metros cuadrados encerrada por un anillo, calculada sobre la esfera auténtica
+func sphericalRingArea(ring []entities.Position) float64 {
+	radius := wgs84Ellipsoid.a * math.Sqrt(authalicQ(1)/2)
+	var total float64
+	for i := 0; i < len(ring)-1; i++ {
+		p, q := ring[i], ring[i+1]
+		total += toRadians(q[0]-p[0]) * (2 + math.Sin(authalicLatitude(toRadians(p[1]))) + math.Sin(authalicLatitude(toRadians(q[1]))))
+	}
+	return math.Abs(total * radius * radius / 2)
+}
+
+// planarRingArea es el área con signo del anillo en grados cuadrados, relativa a origin
+func planarRingArea(ring []entities.Position, origin entities.Position) float64 {
+	var total float64
+	for i := 0; i < len(ring)-1; i++ {
+		x1, y1 := ring[i][0]-origin[0], ring[i][1]-origin[1]
+		x2, y2 := ring[i+1][0]-origin[0], ring[i+1][1]-origin[1]
+		total += x1*y2 - x2*y1
+	}
+	return total / 2
+}
+
+// planarRingCentroid es el centroide del anillo relativo a origin
+func planarRingCentroid(ring []entities.Position, origin entities.Position) (float64, float64) {
+	area := planarRingArea(ring, origin)
+	if area == 0 {
+		return 0, 0
+	}
+	var x, y float64
+	for i := 0; i < len(ring)-1; i++ {
+		x1, y1 := ring[i][0]-origin[0], ring[i][1]-origin[1]
+		x2, y2 := ring[i+1][0]-origin[0], ring[i+1][1]-origin[1]
+		cross := x1*y2 - x2*y1
+		x += (x1 + x2) * cross
+		y += (y1 + y2) * cross
+	}
+	return x / (6 * area), y / (6 * area)
+}
+
+// GeometryToWKT escribe la geometría como WKT en el orden lng lat
+func GeometryToWKT(geometry *entities.ProjectGeometry) string {
+	if geometry == nil {
+		return ""
+	}
+	rings := make([]string, 0, len(geometry.Coordinates))
+	for _, ring := range geometry.Coordinates {
+		vertices := make([]string, 0, len(ring))
+		for _, position := range ring {
+			vertices = append(vertices, strconv.FormatFloat(position[0], 'f', -1, 64)+" "+strconv.FormatFloat(position[1], 'f', -1, 64))
+		}
+		rings = append(rings, "("+strings.Join(vertices, ", ")+")")
+	}
+	if geometry.Type == entities.GeometryLineString {
+		return "LINESTRING " + strings.Join(rings, ", ")
+	}
+	return "POLYGON (" + strings.Join(rings, ", ") + ")"
+}
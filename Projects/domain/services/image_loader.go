@@ -0,0 +1,7 @@
+package services
+
+// IImageLoader descarga una imagen a partir de su URL, por ejemplo las de la galería guardadas en
+// Cloudinary, para incrustarla en documentos generados por el servidor
+type IImageLoader interface {
+	Load(url string) ([]byte, error)
+}
@@ -193,6 +193,12 @@ func (s *ImageUploadWorkerService) GetResultQueue() <-chan ImageUploadResult {
 	return s.resultQueue
 }
 
+// DeleteUploadedImage elimina una imagen ya subida; se usa para no dejar huérfanas las imágenes de
+// un cambio que finalmente se rechaza. No pasa por la cola: es una sola llamada y no se reintenta
+func (s *ImageUploadWorkerService) DeleteUploadedImage(url string) error {
+	return s.cloudSrv.DeleteImage(url)
+}
+
 // Shutdown cierra el servicio de forma ordenada
 func (s *ImageUploadWorkerService) Shutdown() {
 	s.mu.Lock()
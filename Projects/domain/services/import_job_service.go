@@ -0,0 +1,223 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// ImportTask procesa una importación e informa con progress cuántos elementos lleva procesados
+type ImportTask func(progress func(processed int)) (*entities.ImportReport, error)
+
+type importJobRequest struct {
+	id   string
+	task ImportTask
+}
+
+// importJobPruneInterval es cada cuánto se descartan los trabajos terminados que superaron retention
+const importJobPruneInterval = time.Minute
+
+// ImportJobService procesa las importaciones grandes en segundo plano y guarda en memoria su estado
+// para consultarlo. Los trabajos terminados se descartan después de retention
+type ImportJobService struct {
+	jobQueue   chan importJobRequest
+	shutdown   chan struct{}
+	wg         sync.WaitGroup
+	mu         sync.RWMutex // Protege jobs y el estado de shutdown
+	jobs       map[string]*entities.ImportJob
+	retention  time.Duration
+	isShutdown bool
+}
+
+// NewImportJobService crea el servicio con numWorkers importaciones simultáneas como máximo
+func NewImportJobService(numWorkers int, queueSize int, retention time.Duration) *ImportJobService {
+	service := &ImportJobService{
+		jobQueue:  make(chan importJobRequest, queueSize),
+		shutdown:  make(chan struct{}),
+		jobs:      make(map[string]*entities.ImportJob),
+		retention: retention,
+	}
+
+	for i := 0; i < numWorkers; i++ {
+		service.wg.Add(1)
+		go service.worker(i)
+	}
+	service.wg.Add(1)
+	go service.pruneLoop()
+
+	log.Printf("INFO: ImportJobService iniciado con %d workers", numWorkers)
+	return service
+}
+
+// worker es el loop principal de cada worker
+func (s *ImportJobService) worker(id int) {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case request, ok := <-s.jobQueue:
+			if !ok {
+				return
+			}
+			// Un trabajo recibido después del shutdown no se inicia; Shutdown lo marca como cancelado
+			select {
+			case <-s.shutdown:
+				return
+			default:
+			}
+			s.processJob(id, request)
+		case <-s.shutdown:
+			log.Printf("INFO: Worker de importación %d recibió señal de shutdown", id)
+			return
+		}
+	}
+}
+
+func (s *ImportJobService) processJob(workerID int, request importJobRequest) {
+	s.update(request.id, func(job *entities.ImportJob) { job.Status = entities.ImportJobRunning })
+	log.Printf("INFO: Worker de importación %d procesando trabajo %s", workerID, request.id)
+
+	report, err := request.task(func(processed int) {
+		s.update(request.id, func(job *entities.ImportJob) { job.Processed = processed })
+	})
+
+	s.update(request.id, func(job *entities.ImportJob) {
+		now := time.Now().UTC()
+		job.FinishedAt = &now
+		if err != nil {
+			job.Status = entities.ImportJobFailed
+			job.Error = err.Error()
+			return
+		}
+		job.Status = entities.ImportJobCompleted
+		job.Processed = job.Total
+		job.Report = report
+	})
+	if err != nil {
+		log.Printf("ERROR: Trabajo de importación %s falló: %v", request.id, err)
+		return
+	}
+	log.Printf("SUCCESS: Trabajo de importación %s terminado - Creados: %d, Fallidos: %d", request.id, report.Created, report.Failed)
+}
+
+func (s *ImportJobService) update(id string, fn func(job *entities.ImportJob)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if job, ok := s.jobs[id]; ok {
+		fn(job)
+	}
+}
+
+// SubmitImportJob encola la importación de total elementos y devuelve el trabajo creado
+func (s *ImportJobService) SubmitImportJob(total int, dryRun bool, task ImportTask) (entities.ImportJob, error) {
+	id, err := newImportJobId()
+	if err != nil {
+		return entities.ImportJob{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.isShutdown {
+		return entities.ImportJob{}, fmt.Errorf("%w: servicio en shutdown, no se aceptan nuevos trabajos", entities.ErrUnavailable)
+	}
+	s.pruneLocked()
+
+	job := &entities.ImportJob{
+		Id:        id,
+		Status:    entities.ImportJobQueued,
+		DryRun:    dryRun,
+		Total:     total,
+		CreatedAt: time.Now().UTC(),
+	}
+	select {
+	case s.jobQueue <- importJobRequest{id: id, task: task}:
+		s.jobs[id] = job
+		return *job, nil
+	default:
+		return entities.ImportJob{}, fmt.Errorf("%w: cola de importación llena, intente más tarde", entities.ErrUnavailable)
+	}
+}
+
+// GetImportJob devuelve el estado actual de un trabajo
+func (s *ImportJobService) GetImportJob(id string) (entities.ImportJob, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return entities.ImportJob{}, fmt.Errorf("trabajo de importación %w", entities.ErrNotFound)
+	}
+	return *job, nil
+}
+
+// pruneLoop descarta periódicamente los trabajos vencidos aunque no lleguen trabajos nuevos
+func (s *ImportJobService) pruneLoop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(importJobPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.mu.Lock()
+			s.pruneLocked()
+			s.mu.Unlock()
+		case <-s.shutdown:
+			return
+		}
+	}
+}
+
+// pruneLocked descarta los trabajos que terminaron hace más de retention; se llama con mu tomado
+func (s *ImportJobService) pruneLocked() {
+	cutoff := time.Now().Add(-s.retention)
+	for id, job := range s.jobs {
+		if job.FinishedAt != nil && job.FinishedAt.Before(cutoff) {
+			delete(s.jobs, id)
+		}
+	}
+}
+
+func newImportJobId() (string, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("error al generar el id del trabajo: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Shutdown cierra el servicio de forma ordenada; los trabajos en curso terminan antes de salir y los
+// que seguían en la cola quedan cancelados
+func (s *ImportJobService) Shutdown() {
+	s.mu.Lock()
+	if s.isShutdown {
+		s.mu.Unlock()
+		return
+	}
+	// La señal se cierra con mu tomado: quien ve isShutdown sabe que los workers ya no inician trabajos
+	s.isShutdown = true
+	close(s.shutdown)
+	s.mu.Unlock()
+
+	log.Println("INFO: Iniciando shutdown de ImportJobService...")
+	close(s.jobQueue)
+	s.wg.Wait()
+
+	s.mu.Lock()
+	canceled := 0
+	now := time.Now().UTC()
+	for _, job := range s.jobs {
+		if job.Status == entities.ImportJobQueued {
+			job.Status = entities.ImportJobCanceled
+			job.Error = "el servidor se detuvo antes de iniciar la importación"
+			job.FinishedAt = &now
+			canceled++
+		}
+	}
+	s.mu.Unlock()
+	log.Printf("INFO: ImportJobService shutdown completado (%d trabajos cancelados)", canceled)
+}
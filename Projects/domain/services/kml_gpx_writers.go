@@ -0,0 +1,220 @@
+package services
+
+import (
+	"encoding/xml"
+	"fmt"
+	"hash/fnv"
+	"html"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// categoryPalette son los colores (RRGGBB) asignados a las categorías de forma estable
+var categoryPalette = []string{
+	"E6194B", "3CB44B", "FFE119", "4363D8", "F58231",
+	"911EB4", "42D4F4", "F032E6", "BFEF45", "469990",
+}
+
+// CategoryColor devuelve un color RRGGBB estable para una categoría, sin distinguir acentos ni mayúsculas
+func CategoryColor(categoria string) string {
+	h := fnv.New32a()
+	h.Write([]byte(NormalizeText(strings.TrimSpace(categoria))))
+	return categoryPalette[h.Sum32()%uint32(len(categoryPalette))]
+}
+
+// kmlColor convierte RRGGBB al formato aabbggrr de KML con opacidad total
+func kmlColor(rgb string) string {
+	return "ff" + rgb[4:6] + rgb[2:4] + rgb[0:2]
+}
+
+// kmlDefaultStyleId es el estilo de los proyectos cuya categoría no está en el catálogo exportado
+const kmlDefaultStyleId = "categoria-default"
+
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+// kmlStyle es el estilo compartido por los Placemark de una categoría
+type kmlStyle struct {
+	XMLName   xml.Name `xml:"Style"`
+	Id        string   `xml:"id,attr"`
+	IconStyle struct {
+		Color string `xml:"color"`
+		Scale string `xml:"scale"`
+	} `xml:"IconStyle"`
+}
+
+type kmlPlacemark struct {
+	XMLName     xml.Name `xml:"Placemark"`
+	Id          string   `xml:"id,attr"`
+	Name        string   `xml:"name"`
+	Description struct {
+		Text string `xml:",cdata"`
+	} `xml:"description"`
+	StyleUrl     string `xml:"styleUrl"`
+	ExtendedData struct {
+		Data []kmlData `xml:"Data"`
+	} `xml:"ExtendedData"`
+	Point struct {
+		Coordinates string `xml:"coordinates"`
+	} `xml:"Point"`
+}
+
+// KMLWriter escribe un documento KML de forma incremental, un Placemark por proyecto
+type KMLWriter struct {
+	w      io.Writer
+	enc    *xml.Encoder
+	styles map[int]string
+}
+
+// NewKMLWriter escribe la cabecera del documento KML con un estilo por categoría del catálogo, que los
+// Placemark referencian con styleUrl en lugar de repetirlo
+func NewKMLWriter(w io.Writer, documentName string, categories []entities.Category) (*KMLWriter, error) {
+	header := xml.Header + `<kml xmlns="http://www.opengis.net/kml/2.2"><Document><name>`
+	if _, err := io.WriteString(w, header); err != nil {
+		return nil, err
+	}
+	if err := xml.EscapeText(w, []byte(documentName)); err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(w, `</name>`); err != nil {
+		return nil, err
+	}
+
+	k := &KMLWriter{w: w, enc: xml.NewEncoder(w), styles: make(map[int]string, len(categories))}
+	for _, category := range categories {
+		id := fmt.Sprintf("categoria-%d", category.Id)
+		k.styles[category.Id] = id
+		if err := k.enc.Encode(newKMLStyle(id, categoryRGB(category))); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.enc.Encode(newKMLStyle(kmlDefaultStyleId, categoryPalette[3])); err != nil {
+		return nil, err
+	}
+	return k, k.enc.Flush()
+}
+
+func newKMLStyle(id, rgb string) kmlStyle {
+	style := kmlStyle{Id: id}
+	style.IconStyle.Color = kmlColor(rgb)
+	style.IconStyle.Scale = "1.1"
+	return style
+}
+
+// categoryRGB es el color RRGGBB de la categoría del catálogo, o el de la paleta si no tiene uno válido
+func categoryRGB(category entities.Category) string {
+	rgb := strings.TrimPrefix(category.Color, "#")
+	if len(rgb) != 6 {
+		return CategoryColor(category.Nombre)
+	}
+	return strings.ToUpper(rgb)
+}
+
+// WriteProject agrega el Placemark de un proyecto con el estilo de su categoría y el enlace a su imagen
+func (k *KMLWriter) WriteProject(project entities.Project) error {
+	var placemark kmlPlacemark
+	placemark.Id = fmt.Sprintf("project-%d", project.Id)
+	placemark.Name = project.NombreProyecto
+
+	var description strings.Builder
+	if project.Descripcion != "" {
+		description.WriteString("<p>" + html.EscapeString(project.Descripcion) + "</p>")
+	}
+	if project.Img != "" {
+		img := html.EscapeString(project.Img)
+		description.WriteString(`<p><a href="` + img + `"><img src="` + img + `" width="300"/></a></p>`)
+	}
+	placemark.Description.Text = description.String()
+
+	placemark.StyleUrl = "#" + kmlDefaultStyleId
+	if id, ok := k.styles[project.CategoryId]; ok {
+		placemark.StyleUrl = "#" + id
+	}
+	placemark.ExtendedData.Data = []kmlData{
+		{Name: "categoria", Value: project.Categoria},
+		{Name: "fecha", Value: project.Fecha.UTC().Format(time.RFC3339)},
+		{Name: "userId", Value: fmt.Sprint(project.UserId)},
+	}
+	placemark.Point.Coordinates = fmt.Sprintf("%f,%f,0", project.Lng, project.Lat)
+
+	if err := k.enc.Encode(placemark); err != nil {
+		return err
+	}
+	return k.enc.Flush()
+}
+
+// Close cierra el documento KML
+func (k *KMLWriter) Close() error {
+	_, err := io.WriteString(k.w, `</Document></kml>`)
+	return err
+}
+
+type gpxLink struct {
+	Href string `xml:"href,attr"`
+	Text string `xml:"text,omitempty"`
+}
+
+type gpxWaypoint struct {
+	XMLName xml.Name `xml:"wpt"`
+	Lat     string   `xml:"lat,attr"`
+	Lon     string   `xml:"lon,attr"`
+	Name    string   `xml:"name"`
+	Desc    string   `xml:"desc,omitempty"`
+	Link    *gpxLink `xml:"link,omitempty"`
+	Type    string   `xml:"type,omitempty"`
+}
+
+// GPXWriter escribe un archivo GPX 1.1 de forma incremental, un waypoint por proyecto
+type GPXWriter struct {
+	w   io.Writer
+	enc *xml.Encoder
+}
+
+// NewGPXWriter escribe la cabecera del archivo GPX
+func NewGPXWriter(w io.Writer) (*GPXWriter, error) {
+	header := xml.Header + `<gpx version="1.1" creator="Geova" xmlns="http://www.topografix.com/GPX/1/1">`
+	if _, err := io.WriteString(w, header); err != nil {
+		return nil, err
+	}
+	return &GPXWriter{w: w, enc: xml.NewEncoder(w)}, nil
+}
+
+// WriteProject agrega el waypoint de un proyecto
+func (g *GPXWriter) WriteProject(project entities.Project) error {
+	waypoint := gpxWaypoint{
+		Lat:  fmt.Sprintf("%f", project.Lat),
+		Lon:  fmt.Sprintf("%f", gpxLongitude(project.Lng)),
+		Name: project.NombreProyecto,
+		Desc: project.Descripcion,
+		Type: project.Categoria,
+	}
+	if project.Img != "" {
+		waypoint.Link = &gpxLink{Href: project.Img, Text: "Imagen"}
+	}
+	if err := g.enc.Encode(waypoint); err != nil {
+		return err
+	}
+	return g.enc.Flush()
+}
+
+// gpxLongitude ajusta la longitud al rango [-180, 180) que exige GPX 1.1: el antimeridiano se escribe
+// como -180. Se redondea primero a los 6 decimales con que se escribe para no producir 180.000000
+func gpxLongitude(lng float64) float64 {
+	lng = math.Round(lng*1e6) / 1e6
+	if lng >= 180 {
+		return lng - 360
+	}
+	return lng
+}
+
+// Close cierra el archivo GPX
+func (g *GPXWriter) Close() error {
+	_, err := io.WriteString(g.w, `</gpx>`)
+	return err
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// MaxMapZoom es el zoom más alto que usan los mapas web con teselas de 256 px
+const MaxMapZoom = 22
+
+// MapPointsMinZoom es el zoom desde el que se devuelven los proyectos uno a uno en lugar de agrupados
+const MapPointsMinZoom = 16
+
+// MercatorMaxLat es la latitud límite de la proyección Web Mercator; más allá se recorta
+const MercatorMaxLat = 85.05112878
+
+// mapCellsPerTile divide cada tesela en 4x4 celdas, es decir, celdas de 64 px en pantalla
+const mapCellsPerTile = 4
+
+// maxMapCells limita las celdas que puede abarcar un bbox, para que un área enorme con zoom alto no
+// produzca una cuadrícula desproporcionada
+const maxMapCells = 65536
+
+// ParseMapZoom valida el zoom del mapa. Se aceptan zooms fraccionarios, que se truncan al entero
+func ParseMapZoom(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("%w: el parámetro zoom es obligatorio", entities.ErrInvalidFilter)
+	}
+	zoom, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(zoom) || zoom < 0 || zoom > MaxMapZoom {
+		return 0, fmt.Errorf("%w: zoom debe ser un número entre 0 y %d", entities.ErrInvalidFilter, MaxMapZoom)
+	}
+	return int(zoom), nil
+}
+
+// MapGridSize es el número de celdas por eje de la cuadrícula del mundo en un zoom
+func MapGridSize(zoom int) int {
+	return mapCellsPerTile << zoom
+}
+
+// MapCell devuelve la columna y la fila de la celda Web Mercator que contiene el punto; la fila 0 es
+// la del norte. El repositorio calcula la misma celda en SQL
+func MapCell(lat, lng float64, gridSize int) (int, int) {
+	lat = math.Max(-MercatorMaxLat, math.Min(MercatorMaxLat, lat))
+	phi := toRadians(lat)
+	n := float64(gridSize)
+
+	x := int(math.Floor((lng + 180) / 360 * n))
+	y := int(math.Floor((1 - math.Log(math.Tan(phi)+1/math.Cos(phi))/math.Pi) / 2 * n))
+	return min(max(x, 0), gridSize-1), min(max(y, 0), gridSize-1)
+}
+
+// MapGridCells cuenta las celdas de la cuadrícula que abarca el bbox, incluso si cruza el antimeridiano
+func MapGridCells(bbox entities.BoundingBox, gridSize int) int {
+	minX, minY := MapCell(bbox.MaxLat, bbox.MinLng, gridSize)
+	maxX, maxY := MapCell(bbox.MinLat, bbox.MaxLng, gridSize)
+	columns := maxX - minX
+	if bbox.MinLng > bbox.MaxLng {
+		columns += gridSize
+	}
+	return (columns + 1) * (maxY - minY + 1)
+}
+
+// ValidateMapArea rechaza los bbox que abarcan demasiadas celdas para el zoom pedido
+func ValidateMapArea(bbox entities.BoundingBox, gridSize int) error {
+	if MapGridCells(bbox, gridSize) > maxMapCells {
+		return fmt.Errorf("%w: el bbox es demasiado grande para el zoom indicado", entities.ErrInvalidFilter)
+	}
+	return nil
+}
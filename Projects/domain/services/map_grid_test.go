@@ -0,0 +1,50 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+func TestMapClusterGrid(t *testing.T) {
+	if zoom, err := ParseMapZoom("12.7"); err != nil || zoom != 12 {
+		t.Errorf("zoom fraccionario inesperado: %d (%v)", zoom, err)
+	}
+	for _, value := range []string{"", "-1", "23", "abc"} {
+		if _, err := ParseMapZoom(value); !errors.Is(err, entities.ErrInvalidFilter) {
+			t.Errorf("se esperaba rechazar el zoom %q, obtenido %v", value, err)
+		}
+	}
+
+	gridSize := MapGridSize(0)
+	cells := []struct {
+		lat, lng float64
+		x, y     int
+	}{
+		{0.5, 0.5, 2, 1},
+		{-0.5, -0.5, 1, 2},
+		{89, -180, 0, 0},
+		{-89, 180, 3, 3},
+		{66.6, 90.1, 3, 0},
+	}
+	for _, c := range cells {
+		if x, y := MapCell(c.lat, c.lng, gridSize); x != c.x || y != c.y {
+			t.Errorf("celda de (%v, %v) = (%d, %d), se esperaba (%d, %d)", c.lat, c.lng, x, y, c.x, c.y)
+		}
+	}
+
+	// En zoom 10 la cuadrícula tiene 4096 celdas por eje; el bbox cruza el antimeridiano
+	pacific := entities.BoundingBox{MinLng: 179.9, MinLat: -0.1, MaxLng: -179.9, MaxLat: 0.1}
+	if got := MapGridCells(pacific, MapGridSize(10)); got != 4*4 {
+		t.Errorf("se esperaban 16 celdas en el antimeridiano, obtenido %d", got)
+	}
+	world := entities.BoundingBox{MinLng: -180, MinLat: -85, MaxLng: 180, MaxLat: 85}
+	if err := ValidateMapArea(world, MapGridSize(3)); err != nil {
+		t.Errorf("el mundo completo debería aceptarse en zoom 3: %v", err)
+	}
+	if err := ValidateMapArea(world, MapGridSize(10)); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("se esperaba rechazar el mundo completo en zoom 10, obtenido %v", err)
+	}
+
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+const (
+	// MaxMeasurementsPerBatch limita los puntos que un dispositivo envía en una sola petición
+	MaxMeasurementsPerBatch = 5000
+	// maxInstrumentLength y maxNotesLength coinciden con el tamaño de las columnas
+	maxInstrumentLength = 100
+	maxNotesLength      = 1000
+	// minElevation y maxElevation acotan la elevación a valores posibles en la superficie terrestre
+	minElevation = -500.0
+	maxElevation = 9000.0
+	// maxAccuracy es el primer valor que no cabe en la columna accuracy DECIMAL(8, 3)
+	maxAccuracy = 100000.0
+)
+
+// ValidateMeasurement limpia y valida un punto antes de guardarlo
+func ValidateMeasurement(measurement *entities.Measurement) error {
+	if err := ValidateCoordinates(measurement.Lat, measurement.Lng); err != nil {
+		return fmt.Errorf("%w: %v", entities.ErrInvalidInput, err)
+	}
+	if measurement.MeasuredAt.IsZero() {
+		return fmt.Errorf("%w: measured_at es obligatorio", entities.ErrInvalidInput)
+	}
+	measurement.MeasuredAt = measurement.MeasuredAt.UTC()
+	if e := measurement.Elevation; e != nil && (math.IsNaN(*e) || *e < minElevation || *e > maxElevation) {
+		return fmt.Errorf("%w: elevación %v fuera del rango [%v, %v]", entities.ErrInvalidInput, *e, minElevation, maxElevation)
+	}
+	if a := measurement.Accuracy; a != nil && (math.IsNaN(*a) || *a < 0) {
+		return fmt.Errorf("%w: la precisión no puede ser negativa", entities.ErrInvalidInput)
+	}
+	// Se compara el valor ya redondeado a milímetros, que es lo que guarda la columna
+	if a := measurement.Accuracy; a != nil && math.Round(*a*1000)/1000 >= maxAccuracy {
+		return fmt.Errorf("%w: la precisión debe ser menor que %v m", entities.ErrInvalidInput, maxAccuracy)
+	}
+
+	measurement.Instrument = strings.TrimSpace(measurement.Instrument)
+	measurement.Notes = strings.TrimSpace(measurement.Notes)
+	if utf8.RuneCountInString(measurement.Instrument) > maxInstrumentLength {
+		return fmt.Errorf("%w: el instrumento supera los %d caracteres", entities.ErrInvalidInput, maxInstrumentLength)
+	}
+	if utf8.RuneCountInString(measurement.Notes) > maxNotesLength {
+		return fmt.Errorf("%w: las notas superan los %d caracteres", entities.ErrInvalidInput, maxNotesLength)
+	}
+	return nil
+}
+
+// ValidateMeasurementBatch valida un lote completo; el error indica la posición del punto inválido
+func ValidateMeasurementBatch(measurements []entities.Measurement) error {
+	if len(measurements) == 0 {
+		return fmt.Errorf("%w: se requiere al menos un punto", entities.ErrInvalidInput)
+	}
+	if len(measurements) > MaxMeasurementsPerBatch {
+		return fmt.Errorf("%w: se permiten como máximo %d puntos por petición", entities.ErrInvalidInput, MaxMeasurementsPerBatch)
+	}
+	for i := range measurements {
+		if err := ValidateMeasurement(&measurements[i]); err != nil {
+			return fmt.Errorf("punto %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package services
+
+import "encoding/json"
+
+// MergePatch aplica un JSON Merge Patch (RFC 7396) sobre un documento JSON: los miembros del
+// parche reemplazan a los del documento, los objetos se combinan de forma recursiva y null elimina
+func MergePatch(document, patch []byte) ([]byte, error) {
+	var target interface{}
+	if err := json.Unmarshal(document, &target); err != nil {
+		return nil, err
+	}
+	var changes interface{}
+	if err := json.Unmarshal(patch, &changes); err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergeValue(target, changes))
+}
+
+func mergeValue(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{})
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergeValue(targetObject[key], value)
+	}
+	return targetObject
+}
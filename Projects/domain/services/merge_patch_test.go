@@ -0,0 +1,25 @@
+package services
+
+import (
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	cases := []struct{ document, patch, want string }{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+	}
+	for _, tc := range cases {
+		got, err := MergePatch([]byte(tc.document), []byte(tc.patch))
+		if err != nil {
+			t.Errorf("%s + %s: error inesperado: %v", tc.document, tc.patch, err)
+			continue
+		}
+		if string(got) != tc.want {
+			t.Errorf("%s + %s: esperado %s, obtenido %s", tc.document, tc.patch, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,469 @@
+package services
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// Tamaño de página A4 en puntos
+const (
+	PDFPageWidth  = 595.28
+	PDFPageHeight = 841.89
+)
+
+// maxPDFImageSide es el lado máximo en píxeles de las imágenes incrustadas; las más grandes se reducen
+// para que el documento no pese lo mismo que las fotos originales
+const maxPDFImageSide = 1600
+
+// maxPDFImagePixels limita las imágenes que se decodifican: una imagen de pocos KB puede declarar
+// dimensiones enormes y reservar gigabytes al decodificarla (unos 4 bytes por píxel)
+const maxPDFImagePixels = 50_000_000
+
+// PDFDocument genera documentos PDF sencillos sin dependencias externas: páginas A4, texto con las
+// fuentes estándar Helvetica y Helvetica-Bold (codificación WinAnsi, suficiente para español), líneas,
+// rectángulos e imágenes. Las coordenadas se miden en puntos desde la esquina superior izquierda y en
+// los textos y es la línea base
+type PDFDocument struct {
+	title   string
+	pages   []*bytes.Buffer
+	current int
+	images  []*PDFImage
+}
+
+// NewPDFDocument crea un documento vacío; title se guarda en sus propiedades
+func NewPDFDocument(title string) *PDFDocument {
+	return &PDFDocument{title: title, current: -1}
+}
+
+// AddPage agrega una página al final y la vuelve la página actual
+func (d *PDFDocument) AddPage() {
+	d.pages = append(d.pages, new(bytes.Buffer))
+	d.current = len(d.pages) - 1
+}
+
+// PageCount devuelve el número de páginas
+func (d *PDFDocument) PageCount() int {
+	return len(d.pages)
+}
+
+// SetPage cambia la página en la que se dibuja (desde 0), por ejemplo para agregar los pies de página
+// cuando ya se conoce el total
+func (d *PDFDocument) SetPage(index int) {
+	if index >= 0 && index < len(d.pages) {
+		d.current = index
+	}
+}
+
+func (d *PDFDocument) page() *bytes.Buffer {
+	if d.current < 0 {
+		d.AddPage()
+	}
+	return d.pages[d.current]
+}
+
+// SetFillColor cambia el color de los textos y rellenos (componentes de 0 a 1)
+func (d *PDFDocument) SetFillColor(r, g, b float64) {
+	fmt.Fprintf(d.page(), "%s %s %s rg\n", pdfNumber(r), pdfNumber(g), pdfNumber(b))
+}
+
+// SetStrokeColor cambia el color de las líneas y bordes (componentes de 0 a 1)
+func (d *PDFDocument) SetStrokeColor(r, g, b float64) {
+	fmt.Fprintf(d.page(), "%s %s %s RG\n", pdfNumber(r), pdfNumber(g), pdfNumber(b))
+}
+
+// Text escribe una línea de texto a partir de (x, y)
+func (d *PDFDocument) Text(x, y, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(d.page(), "BT /%s %s Tf %s %s Td (%s) Tj ET\n", font, pdfNumber(size), pdfNumber(x), pdfNumber(PDFPageHeight-y), pdfEscape(pdfEncodeText(text)))
+}
+
+// Line traza una línea de (x1, y1) a (x2, y2)
+func (d *PDFDocument) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(d.page(), "%s w %s %s m %s %s l S\n", pdfNumber(width), pdfNumber(x1), pdfNumber(PDFPageHeight-y1), pdfNumber(x2), pdfNumber(PDFPageHeight-y2))
+}
+
+// Polyline traza una línea por los puntos dados; con closed la cierra y, con fill, además la rellena
+func (d *PDFDocument) Polyline(points [][2]float64, closed, fill bool, width float64) {
+	if len(points) < 2 {
+		return
+	}
+	page := d.page()
+	fmt.Fprintf(page, "%s w", pdfNumber(width))
+	for i, p := range points {
+		operator := "l"
+		if i == 0 {
+			operator = "m"
+		}
+		fmt.Fprintf(page, " %s %s %s", pdfNumber(p[0]), pdfNumber(PDFPageHeight-p[1]), operator)
+	}
+	switch {
+	case closed && fill:
+		page.WriteString(" b\n")
+	case closed:
+		page.WriteString(" s\n")
+	default:
+		page.WriteString(" S\n")
+	}
+}
+
+// Rect dibuja un rectángulo con esquina superior izquierda en (x, y); fill lo rellena en lugar de
+// trazar su borde
+func (d *PDFDocument) Rect(x, y, w, h float64, fill bool) {
+	operator := "S"
+	if fill {
+		operator = "f"
+	}
+	fmt.Fprintf(d.page(), "%s %s %s %s re %s\n", pdfNumber(x), pdfNumber(PDFPageHeight-y-h), pdfNumber(w), pdfNumber(h), operator)
+}
+
+// DrawImage dibuja la imagen en el rectángulo con esquina superior izquierda en (x, y)
+func (d *PDFDocument) DrawImage(img *PDFImage, x, y, w, h float64) {
+	if img.id == 0 {
+		d.images = append(d.images, img)
+		img.id = len(d.images)
+	}
+	fmt.Fprintf(d.page(), "q %s 0 0 %s %s %s cm /Im%d Do Q\n", pdfNumber(w), pdfNumber(h), pdfNumber(x), pdfNumber(PDFPageHeight-y-h), img.id)
+}
+
+// Write escribe el documento completo en w
+func (d *PDFDocument) Write(w io.Writer) error {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	out := &pdfWriter{w: w}
+	out.printf("%%PDF-1.4\n%%\xE2\xE3\xCF\xD3\n")
+
+	// Objetos fijos: 1 catálogo, 2 árbol de páginas, 3 y 4 fuentes, 5 propiedades. Después van las
+	// imágenes y cada página seguida de su contenido
+	firstImage := 6
+	firstPage := firstImage + len(d.images)
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = strconv.Itoa(firstPage+2*i) + " 0 R"
+	}
+
+	out.object(1, "<< /Type /Catalog /Pages 2 0 R >>")
+	out.object(2, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	out.object(3, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	out.object(4, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	out.object(5, fmt.Sprintf("<< /Title (%s) /Producer (Geova) >>", pdfEscape(pdfEncodeText(d.title))))
+
+	xObjects := make([]string, len(d.images))
+	for i, img := range d.images {
+		xObjects[i] = fmt.Sprintf("/Im%d %d 0 R", img.id, firstImage+i)
+		out.stream(firstImage+i, fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /%s /BitsPerComponent 8 /Filter /DCTDecode",
+			img.Width, img.Height, img.colorSpace), img.data)
+	}
+	resources := "<< /Font << /F1 3 0 R /F2 4 0 R >> /XObject << " + strings.Join(xObjects, " ") + " >> >>"
+
+	for i, content := range d.pages {
+		id := firstPage + 2*i
+		out.object(id, fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources %s /Contents %d 0 R >>",
+			pdfNumber(PDFPageWidth), pdfNumber(PDFPageHeight), resources, id+1))
+
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		zw.Write(content.Bytes())
+		zw.Close()
+		out.stream(id+1, "/Filter /FlateDecode", compressed.Bytes())
+	}
+
+	total := firstPage + 2*len(d.pages)
+	xref := out.n
+	out.printf("xref\n0 %d\n0000000000 65535 f \n", total)
+	for id := 1; id < total; id++ {
+		out.printf("%010d 00000 n \n", out.offsets[id])
+	}
+	out.printf("trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", total, xref)
+	return out.err
+}
+
+// pdfWriter lleva la posición de cada objeto para la tabla xref y conserva el primer error
+type pdfWriter struct {
+	w       io.Writer
+	n       int64
+	offsets map[int]int64
+	err     error
+}
+
+func (p *pdfWriter) write(data []byte) {
+	if p.err != nil {
+		return
+	}
+	n, err := p.w.Write(data)
+	p.n += int64(n)
+	p.err = err
+}
+
+func (p *pdfWriter) printf(format string, args ...interface{}) {
+	p.write([]byte(fmt.Sprintf(format, args...)))
+}
+
+func (p *pdfWriter) startObject(id int) {
+	if p.offsets == nil {
+		p.offsets = make(map[int]int64)
+	}
+	p.offsets[id] = p.n
+	p.printf("%d 0 obj\n", id)
+}
+
+func (p *pdfWriter) object(id int, body string) {
+	p.startObject(id)
+	p.printf("%s\nendobj\n", body)
+}
+
+func (p *pdfWriter) stream(id int, dict string, data []byte) {
+	p.startObject(id)
+	p.printf("<< %s /Length %d >>\nstream\n", dict, len(data))
+	p.write(data)
+	p.printf("\nendstream\nendobj\n")
+}
+
+// PDFImage es una imagen lista para incrustar en un PDF como JPEG
+type PDFImage struct {
+	Width      int
+	Height     int
+	colorSpace string
+	data       []byte
+	id         int
+}
+
+// NewPDFImage prepara una imagen JPEG, PNG o GIF. Los JPEG en RGB o escala de grises de tamaño
+// razonable se incrustan tal cual; las demás imágenes se reducen a maxPDFImageSide, se colocan sobre
+// fondo blanco si tienen transparencia y se vuelven a codificar como JPEG. Las imágenes de más de
+// maxPDFImagePixels píxeles se rechazan antes de decodificarlas
+func NewPDFImage(data []byte) (*PDFImage, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("imagen no soportada: %w", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || int64(config.Width)*int64(config.Height) > maxPDFImagePixels {
+		return nil, fmt.Errorf("%w: la imagen de %dx%d píxeles supera el máximo de %d píxeles", entities.ErrInvalidInput, config.Width, config.Height, maxPDFImagePixels)
+	}
+	if format == "jpeg" && config.Width <= maxPDFImageSide && config.Height <= maxPDFImageSide {
+		switch config.ColorModel {
+		case color.YCbCrModel:
+			return &PDFImage{Width: config.Width, Height: config.Height, colorSpace: "DeviceRGB", data: data}, nil
+		case color.GrayModel:
+			return &PDFImage{Width: config.Width, Height: config.Height, colorSpace: "DeviceGray", data: data}, nil
+		}
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("imagen no soportada: %w", err)
+	}
+	bounds := decoded.Bounds()
+	width, height := fitImageSide(bounds.Dx(), bounds.Dy())
+	flat := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(flat, flat.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), scaleImage(decoded, width, height), image.Point{}, draw.Over)
+
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, flat, &jpeg.Options{Quality: 85}); err != nil {
+		return nil, err
+	}
+	return &PDFImage{Width: width, Height: height, colorSpace: "DeviceRGB", data: encoded.Bytes()}, nil
+}
+
+// fitImageSide reduce las dimensiones, conservando la proporción, para que ningún lado supere maxPDFImageSide
+func fitImageSide(width, height int) (int, int) {
+	longest := max(width, height)
+	if longest <= maxPDFImageSide {
+		return width, height
+	}
+	return max(width*maxPDFImageSide/longest, 1), max(height*maxPDFImageSide/longest, 1)
+}
+
+// scaleImage reduce la imagen tomando, para cada píxel de destino, el promedio del bloque de origen
+// que le corresponde
+func scaleImage(src image.Image, width, height int) image.Image {
+	bounds := src.Bounds()
+	if bounds.Dx() == width && bounds.Dy() == height {
+		return src
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(bounds.Min.Y+(y+1)*bounds.Dy()/height, y0+1)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(bounds.Min.X+(x+1)*bounds.Dx()/width, x0+1)
+			var r, g, b, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, b, a, n = r+pr, g+pg, b+pb, a+pa, n+1
+				}
+			}
+			dst.Set(x, y, color.RGBA64{uint16(r / n), uint16(g / n), uint16(b / n), uint16(a / n)})
+		}
+	}
+	return dst
+}
+
+// PDFTextWidth devuelve el ancho en puntos del texto con el tamaño y la fuente indicados
+func PDFTextWidth(text string, size float64, bold bool) float64 {
+	widths := &helveticaWidths
+	if bold {
+		widths = &helveticaBoldWidths
+	}
+	total := 0
+	for _, b := range []byte(pdfEncodeText(text)) {
+		if b >= 0xC0 {
+			// Las letras acentuadas miden lo mismo que su letra base
+			b = latin1BaseLetters[b-0xC0]
+		}
+		if b >= 32 && b <= 126 {
+			total += widths[b-32]
+		} else {
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// PDFWrapText divide el texto en líneas que caben en maxWidth, respetando los saltos de línea. Las
+// palabras más largas que una línea se cortan
+func PDFWrapText(text string, size float64, bold bool, maxWidth float64) []string {
+	lines := make([]string, 0)
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+			if PDFTextWidth(candidate, size, bold) <= maxWidth {
+				line = candidate
+				continue
+			}
+			if line != "" {
+				lines = append(lines, line)
+			}
+			for PDFTextWidth(word, size, bold) > maxWidth {
+				cut := pdfFitRunes(word, size, bold, maxWidth)
+				lines = append(lines, word[:cut])
+				word = word[cut:]
+			}
+			line = word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// PDFTruncateText recorta el texto con puntos suspensivos para que quepa en maxWidth
+func PDFTruncateText(text string, size float64, bold bool, maxWidth float64) string {
+	if PDFTextWidth(text, size, bold) <= maxWidth {
+		return text
+	}
+	ellipsis := "…"
+	cut := pdfFitRunes(text, size, bold, maxWidth-PDFTextWidth(ellipsis, size, bold))
+	return strings.TrimSpace(text[:cut]) + ellipsis
+}
+
+// pdfFitRunes devuelve cuántos bytes del inicio de text caben en maxWidth, sin partir caracteres y
+// tomando al menos uno
+func pdfFitRunes(text string, size float64, bold bool, maxWidth float64) int {
+	cut := 0
+	for i, r := range text {
+		next := i + len(string(r))
+		if cut > 0 && PDFTextWidth(text[:next], size, bold) > maxWidth {
+			break
+		}
+		cut = next
+	}
+	return cut
+}
+
+// winAnsiSpecials son los caracteres de WinAnsi (Windows-1252) entre 0x80 y 0x9F; del 0xA0 al 0xFF
+// coincide con Latin-1
+var winAnsiSpecials = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94,
+	'•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// pdfEncodeText convierte el texto a WinAnsi; los caracteres que la fuente no tiene se reemplazan
+// por "?" y los de control por espacios
+func pdfEncodeText(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r < 32:
+			b.WriteByte(' ')
+		case r < 127 || (r >= 0xA0 && r <= 0xFF):
+			b.WriteByte(byte(r))
+		case winAnsiSpecials[r] != 0:
+			b.WriteByte(winAnsiSpecials[r])
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// pdfEscape escapa una cadena ya codificada para escribirla entre paréntesis
+func pdfEscape(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c >= 127:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// pdfNumber formatea un número con dos decimales como máximo
+func pdfNumber(v float64) string {
+	s := strings.TrimRight(strconv.FormatFloat(v, 'f', 2, 64), "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// latin1BaseLetters es la letra sin acento de cada carácter de 0xC0 a 0xFF
+const latin1BaseLetters = "AAAAAAACEEEEIIIIDNOOOOO*OUUUUYPsaaaaaaaceeeeiiiionooooo/ouuuuypy"
+
+// Anchos de los caracteres 32 a 126 de Helvetica y Helvetica-Bold en milésimas del tamaño de letra
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
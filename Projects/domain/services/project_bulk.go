@@ -0,0 +1,48 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// MaxBulkProjects limita los proyectos de una operación masiva para acotar la duración de la transacción
+const MaxBulkProjects = 500
+
+// IsBulkAction indica si action es una de las operaciones masivas soportadas
+func IsBulkAction(action string) bool {
+	switch action {
+	case entities.BulkActionDelete, entities.BulkActionRecategorize, entities.BulkActionRetag,
+		entities.BulkActionReassign, entities.BulkActionStatus:
+		return true
+	}
+	return false
+}
+
+// NormalizeBulkIds valida los ids de una operación masiva y descarta los repetidos conservando el orden
+func NormalizeBulkIds(ids []int) ([]int, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: se requiere al menos un id de proyecto", entities.ErrInvalidInput)
+	}
+	normalized := make([]int, 0, len(ids))
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, fmt.Errorf("%w: el id %d no es válido", entities.ErrInvalidInput, id)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		normalized = append(normalized, id)
+	}
+	if len(normalized) > MaxBulkProjects {
+		return nil, fmt.Errorf("%w: se permiten como máximo %d proyectos por operación", entities.ErrInvalidInput, MaxBulkProjects)
+	}
+	return normalized, nil
+}
+
+// CanModifyProject aplica la regla de propiedad: solo el autor del proyecto o un administrador lo modifica
+func CanModifyProject(project entities.Project, userId int, isAdmin bool) bool {
+	return isAdmin || (userId > 0 && project.UserId == userId)
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// ProjectColumn es una columna de las exportaciones tabulares. Value devuelve string, int, float64 o
+// time.Time (la hora cero es una celda vacía) para que cada formato escriba el tipo que le corresponde
+type ProjectColumn struct {
+	Key      string
+	HeaderES string
+	HeaderEN string
+	Value    func(p entities.Project) interface{}
+}
+
+// Header devuelve el encabezado de la columna en el idioma lang ("es" o "en")
+func (c ProjectColumn) Header(lang string) string {
+	if lang == "en" {
+		return c.HeaderEN
+	}
+	return c.HeaderES
+}
+
+func projectAddress(p entities.Project) entities.ProjectAddress {
+	if p.Address == nil {
+		return entities.ProjectAddress{}
+	}
+	return *p.Address
+}
+
+// projectColumns son las columnas disponibles, en el orden en que se exportan con columns=all
+var projectColumns = []ProjectColumn{
+	{"id", "ID", "ID", func(p entities.Project) interface{} { return p.Id }},
+	{"nombre", "Nombre", "Name", func(p entities.Project) interface{} { return p.NombreProyecto }},
+	{"fecha", "Fecha", "Date", func(p entities.Project) interface{} { return p.Fecha }},
+	{"categoria", "Categoría", "Category", func(p entities.Project) interface{} { return p.Categoria }},
+	{"descripcion", "Descripción", "Description", func(p entities.Project) interface{} { return p.Descripcion }},
+	{"status", "Estado", "Status", func(p entities.Project) interface{} { return p.Status }},
+	{"lat", "Latitud", "Latitude", func(p entities.Project) interface{} { return p.Lat }},
+	{"lng", "Longitud", "Longitude", func(p entities.Project) interface{} { return p.Lng }},
+	{"municipality", "Municipio", "Municipality", func(p entities.Project) interface{} { return projectAddress(p).Municipality }},
+	{"state", "Estado/Provincia", "State", func(p entities.Project) interface{} { return projectAddress(p).State }},
+	{"country", "País", "Country", func(p entities.Project) interface{} { return projectAddress(p).Country }},
+	{"point_count", "Puntos", "Points", func(p entities.Project) interface{} { return p.PointCount }},
+	{"area_m2", "Área (m²)", "Area (m²)", func(p entities.Project) interface{} { return p.AreaM2 }},
+	{"perimeter_m", "Perímetro (m)", "Perimeter (m)", func(p entities.Project) interface{} { return p.PerimeterM }},
+	{"user_id", "Usuario", "User", func(p entities.Project) interface{} { return p.UserId }},
+	{"img", "Imagen", "Image", func(p entities.Project) interface{} { return p.Img }},
+	{"created_at", "Creado", "Created", func(p entities.Project) interface{} { return p.CreatedAt }},
+	{"updated_at", "Modificado", "Updated", func(p entities.Project) interface{} { return p.UpdatedAt }},
+	{"version", "Versión", "Version", func(p entities.Project) interface{} { return p.Version }},
+}
+
+// defaultProjectColumns son las columnas que se exportan si no se eligen otras
+var defaultProjectColumns = []string{"id", "nombre", "fecha", "categoria", "status", "lat", "lng", "municipality", "state", "user_id"}
+
+// ParseProjectColumns interpreta la lista de columnas separadas por comas; vacía usa las columnas por
+// defecto y "all" todas. Las columnas repetidas se exportan una sola vez
+func ParseProjectColumns(spec string) ([]ProjectColumn, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.EqualFold(spec, "all") {
+		return projectColumns, nil
+	}
+	keys := defaultProjectColumns
+	if spec != "" {
+		keys = strings.Split(spec, ",")
+	}
+
+	columns := make([]ProjectColumn, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		key = strings.ToLower(strings.TrimSpace(key))
+		if key == "" || seen[key] {
+			continue
+		}
+		column, ok := findProjectColumn(key)
+		if !ok {
+			return nil, fmt.Errorf("%w: la columna %q no existe", entities.ErrInvalidFilter, key)
+		}
+		seen[key] = true
+		columns = append(columns, column)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("%w: se requiere al menos una columna", entities.ErrInvalidFilter)
+	}
+	return columns, nil
+}
+
+func findProjectColumn(key string) (ProjectColumn, bool) {
+	for _, column := range projectColumns {
+		if column.Key == key {
+			return column, true
+		}
+	}
+	return ProjectColumn{}, false
+}
+
+// ParseExportLanguage valida el idioma de los encabezados; por defecto español
+func ParseExportLanguage(lang string) (string, error) {
+	switch lang = strings.ToLower(strings.TrimSpace(lang)); lang {
+	case "", "es":
+		return "es", nil
+	case "en":
+		return "en", nil
+	}
+	return "", fmt.Errorf("%w: el idioma %q no está soportado; use es o en", entities.ErrInvalidFilter, lang)
+}
+
+// isZeroTime indica si una celda de fecha debe quedar vacía
+func isZeroTime(value interface{}) bool {
+	t, ok := value.(time.Time)
+	return ok && t.IsZero()
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// projectField obtiene el valor comparable de un campo editable del proyecto
+type projectField struct {
+	name  string
+	value func(entities.Project) interface{}
+}
+
+// editableProjectFields son los campos que registra el historial; Id y las marcas de tiempo se excluyen
+var editableProjectFields = []projectField{
+	{"NombreProyecto", func(p entities.Project) interface{} { return p.NombreProyecto }},
+	{"Fecha", func(p entities.Project) interface{} { return p.Fecha.UTC().Format(time.RFC3339) }},
+	{"Categoria", func(p entities.Project) interface{} { return p.Categoria }},
+	{"CategoryId", func(p entities.Project) interface{} { return p.CategoryId }},
+	{"Descripcion", func(p entities.Project) interface{} { return p.Descripcion }},
+	{"Img", func(p entities.Project) interface{} { return p.Img }},
+	{"Lat", func(p entities.Project) interface{} { return p.Lat }},
+	{"Lng", func(p entities.Project) interface{} { return p.Lng }},
+	{"UserId", func(p entities.Project) interface{} { return p.UserId }},
+	{"Geometry", func(p entities.Project) interface{} { return GeometryToWKT(p.Geometry) }},
+	{"Checklist", func(p entities.Project) interface{} { return checklistValue(p.Checklist) }},
+}
+
+// readOnlyProjectFields se registran en el historial pero el cliente no los modifica: el dueño solo
+// cambia con la reasignación masiva, que comprueba los permisos
+var readOnlyProjectFields = map[string]bool{"UserId": true}
+
+// IsEditableProjectField indica si un campo del proyecto lo puede modificar el cliente
+func IsEditableProjectField(name string) bool {
+	if readOnlyProjectFields[name] {
+		return false
+	}
+	for _, field := range editableProjectFields {
+		if field.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffProjects devuelve los campos editables que difieren entre before y after, en orden estable
+func DiffProjects(before, after entities.Project) []entities.FieldChange {
+	changes := make([]entities.FieldChange, 0)
+	for _, field := range editableProjectFields {
+		from, to := field.value(before), field.value(after)
+		if from != to {
+			changes = append(changes, entities.FieldChange{Field: field.name, From: from, To: to})
+		}
+	}
+	return changes
+}
+
+// ChangedFieldNames devuelve solo los nombres de los campos de un diff
+func ChangedFieldNames(changes []entities.FieldChange) []string {
+	names := make([]string, 0, len(changes))
+	for _, change := range changes {
+		names = append(names, change.Field)
+	}
+	return names
+}
+
+// NewProjectRevision completa los datos del cambio (acción, editor y revisión restaurada) con la copia
+// de after, su versión como número de revisión y los campos editables que cambiaron desde before
+func NewProjectRevision(before, after entities.Project, change entities.ProjectRevision) entities.ProjectRevision {
+	revision := change
+	revision.ProjectId = after.Id
+	revision.Revision = after.Version
+	revision.ChangedFields = ChangedFieldNames(DiffProjects(before, after))
+	revision.PreviousImg = ""
+	if before.Img != after.Img {
+		revision.PreviousImg = before.Img
+	}
+	revision.Snapshot = after
+	return revision
+}
+
+// BaselineRevision es la revisión inicial de un proyecto creado antes de que existiera el historial,
+// para que su primer cambio también se pueda revertir
+func BaselineRevision(current entities.Project) entities.ProjectRevision {
+	return entities.ProjectRevision{
+		ProjectId:     current.Id,
+		Revision:      current.Version,
+		Action:        entities.RevisionActionBaseline,
+		ChangedFields: []string{},
+		Snapshot:      current,
+	}
+}
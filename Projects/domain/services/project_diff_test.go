@@ -0,0 +1,30 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+func TestDiffProjects(t *testing.T) {
+	before := entities.Project{Id: 1, NombreProyecto: "Deslinde", Categoria: "Catastro", Img: "a.jpg", Lat: 19.4, Lng: -99.1, UserId: 1,
+		Fecha: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), UpdatedAt: time.Now()}
+	after := before
+	after.Categoria = "Topografía"
+	after.Img = "b.jpg"
+	after.Fecha = time.Date(2025, 1, 10, 0, 0, 0, 0, time.FixedZone("CST", -6*3600)).In(time.UTC)
+	after.UpdatedAt = time.Now().Add(time.Hour)
+
+	changes := DiffProjects(before, after)
+	names := ChangedFieldNames(changes)
+	if len(names) != 3 || names[0] != "Fecha" || names[1] != "Categoria" || names[2] != "Img" {
+		t.Fatalf("campos modificados inesperados: %v", names)
+	}
+	if changes[2].From != "a.jpg" || changes[2].To != "b.jpg" {
+		t.Errorf("cambio de imagen inesperado: %+v", changes[2])
+	}
+	if len(DiffProjects(before, before)) != 0 {
+		t.Error("no se esperaban cambios al comparar un proyecto consigo mismo")
+	}
+}
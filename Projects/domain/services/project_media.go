@@ -0,0 +1,55 @@
+package services
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+const (
+	// MaxMediaPerUpload limita las imágenes de una sola petición para no saturar la cola de subidas
+	MaxMediaPerUpload = 10
+	// maxCaptionLength coincide con el tamaño de la columna caption
+	maxCaptionLength = 500
+)
+
+// ValidateMediaUploads comprueba la cantidad de imágenes, el largo de los pies de foto y que
+// la ubicación de cada imagen tenga latitud y longitud válidas
+func ValidateMediaUploads(uploads []entities.MediaUpload) error {
+	if len(uploads) > MaxMediaPerUpload {
+		return fmt.Errorf("%w: se permiten como máximo %d imágenes por petición", entities.ErrInvalidInput, MaxMediaPerUpload)
+	}
+	for i, upload := range uploads {
+		if utf8.RuneCountInString(upload.Caption) > maxCaptionLength {
+			return fmt.Errorf("%w: el pie de la imagen %d supera los %d caracteres", entities.ErrInvalidInput, i+1, maxCaptionLength)
+		}
+		if (upload.Lat == nil) != (upload.Lng == nil) {
+			return fmt.Errorf("%w: la imagen %d debe indicar latitud y longitud juntas", entities.ErrInvalidInput, i+1)
+		}
+		if upload.Lat != nil {
+			if err := ValidateCoordinates(*upload.Lat, *upload.Lng); err != nil {
+				return fmt.Errorf("%w: imagen %d: %v", entities.ErrInvalidInput, i+1, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateMediaOrder comprueba que ids contenga exactamente una vez cada imagen de la galería
+func ValidateMediaOrder(current []entities.ProjectMedia, ids []int) error {
+	if len(ids) != len(current) {
+		return fmt.Errorf("%w: el orden debe incluir las %d imágenes de la galería", entities.ErrInvalidInput, len(current))
+	}
+	pending := make(map[int]bool, len(current))
+	for _, media := range current {
+		pending[media.Id] = true
+	}
+	for _, id := range ids {
+		if !pending[id] {
+			return fmt.Errorf("%w: la imagen %d no pertenece a la galería o está repetida", entities.ErrInvalidInput, id)
+		}
+		delete(pending, id)
+	}
+	return nil
+}
@@ -0,0 +1,570 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// reportTemplate son los textos fijos del reporte en un idioma
+type reportTemplate struct {
+	Title, Date, Category, Status, User, Address, Tags string
+	Description, NoDescription, Checklist              string
+	Location, SketchNote, NoLocation                   string
+	Latitude, Longitude, Area, Perimeter, PointCount   string
+	Vertices, MoreVertices                             string
+	Gallery, NoImages, ImageUnavailable                string
+	Measurements, NoMeasurements, MoreMeasurements     string
+	Elevation, Accuracy, Instrument, MeasuredAt, Notes string
+	Footer, Page                                       string
+	DateLayout, DateTimeLayout                         string
+	Statuses                                           map[string]string
+}
+
+// projectReportTemplates son las plantillas del reporte por idioma
+var projectReportTemplates = map[string]reportTemplate{
+	"es": {
+		Title: "Reporte de proyecto", Date: "Fecha", Category: "Categoría", Status: "Estado", User: "Usuario",
+		Address: "Ubicación", Tags: "Etiquetas",
+		Description: "Descripción", NoDescription: "Sin descripción", Checklist: "Lista de verificación",
+		Location: "Ubicación y coordenadas", SketchNote: "Croquis a escala sin mapa base, con el norte hacia arriba",
+		NoLocation: "El proyecto no tiene ubicación registrada",
+		Latitude:   "Latitud", Longitude: "Longitud", Area: "Área (m²)", Perimeter: "Perímetro (m)", PointCount: "Puntos",
+		Vertices: "Vértices", MoreVertices: "… y %d vértices más",
+		Gallery: "Galería", NoImages: "El proyecto no tiene imágenes", ImageUnavailable: "Imagen no disponible",
+		Measurements: "Puntos de medición", NoMeasurements: "El proyecto no tiene puntos de medición",
+		MoreMeasurements: "Se muestran los primeros %d puntos; el resto se puede consultar en la API",
+		Elevation:        "Elev. (m)", Accuracy: "Prec. (m)", Instrument: "Instrumento", MeasuredAt: "Medido", Notes: "Notas",
+		Footer: "Geova · Generado el %s", Page: "Página %d de %d",
+		DateLayout: "02/01/2006", DateTimeLayout: "02/01/2006 15:04",
+		Statuses: map[string]string{
+			entities.StatusDraft: "Borrador", entities.StatusPlanned: "Planeado", entities.StatusInField: "En campo",
+			entities.StatusProcessing: "En procesamiento", entities.StatusDelivered: "Entregado", entities.StatusArchived: "Archivado",
+		},
+	},
+	"en": {
+		Title: "Project report", Date: "Date", Category: "Category", Status: "Status", User: "User",
+		Address: "Location", Tags: "Tags",
+		Description: "Description", NoDescription: "No description", Checklist: "Checklist",
+		Location: "Location and coordinates", SketchNote: "Scaled sketch without base map, north up",
+		NoLocation: "The project has no recorded location",
+		Latitude:   "Latitude", Longitude: "Longitude", Area: "Area (m²)", Perimeter: "Perimeter (m)", PointCount: "Points",
+		Vertices: "Vertices", MoreVertices: "… and %d more vertices",
+		Gallery: "Gallery", NoImages: "The project has no images", ImageUnavailable: "Image not available",
+		Measurements: "Survey points", NoMeasurements: "The project has no survey points",
+		MoreMeasurements: "Showing the first %d points; the rest are available through the API",
+		Elevation:        "Elev. (m)", Accuracy: "Acc. (m)", Instrument: "Instrument", MeasuredAt: "Measured", Notes: "Notes",
+		Footer: "Geova · Generated on %s", Page: "Page %d of %d",
+		DateLayout: "2006-01-02", DateTimeLayout: "2006-01-02 15:04",
+		Statuses: map[string]string{
+			entities.StatusDraft: "Draft", entities.StatusPlanned: "Planned", entities.StatusInField: "In field",
+			entities.StatusProcessing: "Processing", entities.StatusDelivered: "Delivered", entities.StatusArchived: "Archived",
+		},
+	},
+}
+
+// Medidas de la página del reporte en puntos
+const (
+	reportMargin       = 48.0
+	reportContentWidth = PDFPageWidth - 2*reportMargin
+	reportBottom       = PDFPageHeight - 60
+	reportLineHeight   = 14.0
+	// reportMaxVertices es el máximo de vértices de la geometría que se listan
+	reportMaxVertices = 60
+)
+
+// Colores del reporte (RGB de 0 a 1)
+var (
+	reportAccent = [3]float64{0.13, 0.34, 0.52}
+	reportText   = [3]float64{0.15, 0.15, 0.15}
+	reportMuted  = [3]float64{0.45, 0.45, 0.45}
+	reportLight  = [3]float64{0.93, 0.95, 0.97}
+)
+
+// RenderProjectReport genera el reporte de entrega del proyecto en PDF: encabezado con los datos
+// principales, descripción y lista de verificación, croquis y tabla de coordenadas, galería de
+// imágenes y tabla de puntos de medición, con pie de página numerado
+func RenderProjectReport(w io.Writer, report entities.ProjectReport) error {
+	t, ok := projectReportTemplates[report.Language]
+	if !ok {
+		t = projectReportTemplates["es"]
+	}
+	loc := report.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	project := report.Project
+	l := &reportLayout{doc: NewPDFDocument(t.Title + " - " + project.NombreProyecto), t: t, loc: loc}
+	l.doc.AddPage()
+	l.header(project, report.Tags)
+	l.description(project)
+	l.location(project, report.Measurements)
+	l.gallery(report.Images)
+	l.measurements(report.Measurements, report.MoreMeasurements)
+	l.footers(report.GeneratedAt)
+	return l.doc.Write(w)
+}
+
+// reportLayout dibuja el reporte de arriba hacia abajo; y es la posición actual en la página
+type reportLayout struct {
+	doc *PDFDocument
+	t   reportTemplate
+	loc *time.Location
+	y   float64
+}
+
+func (l *reportLayout) color(c [3]float64) {
+	l.doc.SetFillColor(c[0], c[1], c[2])
+}
+
+// ensureSpace pasa a una página nueva si lo que sigue no cabe en la actual
+func (l *reportLayout) ensureSpace(height float64) {
+	if l.y+height > reportBottom {
+		l.doc.AddPage()
+		l.y = reportMargin
+	}
+}
+
+func (l *reportLayout) header(project entities.Project, tags []entities.Tag) {
+	l.color(reportAccent)
+	l.doc.Rect(0, 0, PDFPageWidth, 96, true)
+	l.doc.SetFillColor(1, 1, 1)
+	l.doc.Text(reportMargin, 32, 11, false, l.t.Title)
+	l.doc.Text(reportMargin, 60, 20, true, PDFTruncateText(project.NombreProyecto, 20, true, reportContentWidth))
+	l.doc.Text(reportMargin, 80, 10, false, "ID "+strconv.Itoa(project.Id))
+	l.y = 122
+
+	status := l.t.Statuses[project.Status]
+	if status == "" {
+		status = project.Status
+	}
+	l.fieldsRow([2]string{l.t.Date, l.formatDate(project.Fecha, l.t.DateLayout)}, [2]string{l.t.Category, project.Categoria})
+	l.fieldsRow([2]string{l.t.Status, status}, [2]string{l.t.User, strconv.Itoa(project.UserId)})
+	if address := reportAddress(project.Address); address != "" {
+		l.fieldsRow([2]string{l.t.Address, address})
+	}
+	if len(tags) > 0 {
+		names := make([]string, len(tags))
+		for i, tag := range tags {
+			names[i] = tag.Nombre
+		}
+		l.fieldsRow([2]string{l.t.Tags, strings.Join(names, ", ")})
+	}
+}
+
+// fieldsRow dibuja una fila de campos (etiqueta y valor) que se reparten el ancho de la página
+func (l *reportLayout) fieldsRow(fields ...[2]string) {
+	width := (reportContentWidth - 12*float64(len(fields)-1)) / float64(len(fields))
+	lines := make([][]string, len(fields))
+	height := 0.0
+	for i, field := range fields {
+		value := field[1]
+		if value == "" {
+			value = "-"
+		}
+		lines[i] = PDFWrapText(value, 10, false, width)
+		height = math.Max(height, 12+float64(len(lines[i]))*reportLineHeight)
+	}
+	l.ensureSpace(height)
+
+	for i, field := range fields {
+		x := reportMargin + float64(i)*(width+12)
+		l.color(reportMuted)
+		l.doc.Text(x, l.y, 8, true, field[0])
+		l.color(reportText)
+		for j, line := range lines[i] {
+			l.doc.Text(x, l.y+13+float64(j)*reportLineHeight, 10, false, line)
+		}
+	}
+	l.y += height + 6
+}
+
+// section dibuja el título de una sección; minHeight evita dejar el título solo al final de la página
+func (l *reportLayout) section(title string, minHeight float64) {
+	l.ensureSpace(34 + minHeight)
+	l.y += 12
+	l.color(reportAccent)
+	l.doc.Text(reportMargin, l.y, 13, true, title)
+	l.doc.SetStrokeColor(reportAccent[0], reportAccent[1], reportAccent[2])
+	l.doc.Line(reportMargin, l.y+5, reportMargin+reportContentWidth, l.y+5, 0.8)
+	l.y += 22
+}
+
+// paragraph escribe texto con ajuste de línea
+func (l *reportLayout) paragraph(text string, size float64, c [3]float64) {
+	for _, line := range PDFWrapText(text, size, false, reportContentWidth) {
+		l.ensureSpace(reportLineHeight)
+		l.color(c)
+		l.doc.Text(reportMargin, l.y, size, false, line)
+		l.y += reportLineHeight
+	}
+}
+
+func (l *reportLayout) description(project entities.Project) {
+	l.section(l.t.Description, reportLineHeight)
+	if strings.TrimSpace(project.Descripcion) == "" {
+		l.paragraph(l.t.NoDescription, 10, reportMuted)
+	} else {
+		l.paragraph(project.Descripcion, 10, reportText)
+	}
+
+	if len(project.Checklist) == 0 {
+		return
+	}
+	l.section(l.t.Checklist, reportLineHeight)
+	for _, item := range project.Checklist {
+		mark := "[  ] "
+		if item.Done {
+			mark = "[x] "
+		}
+		l.paragraph(mark+item.Text, 10, reportText)
+	}
+}
+
+func (l *reportLayout) location(project entities.Project, measurements []entities.Measurement) {
+	const sketchHeight = 230.0
+	l.section(l.t.Location, sketchHeight+20)
+	drawReportSketch(l.doc, project, measurements, reportMargin, l.y, reportContentWidth, sketchHeight, l.t.NoLocation)
+	l.y += sketchHeight + 12
+	l.color(reportMuted)
+	l.doc.Text(reportMargin, l.y, 8, false, l.t.SketchNote)
+	l.y += 18
+
+	l.fieldsRow(
+		[2]string{l.t.Latitude, strconv.FormatFloat(project.Lat, 'f', 6, 64)},
+		[2]string{l.t.Longitude, strconv.FormatFloat(project.Lng, 'f', 6, 64)},
+		[2]string{l.t.Area, strconv.FormatFloat(project.AreaM2, 'f', 2, 64)},
+		[2]string{l.t.Perimeter, strconv.FormatFloat(project.PerimeterM, 'f', 2, 64)},
+		[2]string{l.t.PointCount, strconv.Itoa(project.PointCount)},
+	)
+
+	if project.Geometry == nil || len(project.Geometry.Coordinates) == 0 {
+		return
+	}
+	vertices := project.Geometry.Coordinates[0]
+	if project.Geometry.Type == entities.GeometryPolygon && len(vertices) > 1 && vertices[0] == vertices[len(vertices)-1] {
+		// El último vértice del anillo repite el primero
+		vertices = vertices[:len(vertices)-1]
+	}
+	rows := make([][]string, 0, min(len(vertices), reportMaxVertices))
+	for i, vertex := range vertices {
+		if i == reportMaxVertices {
+			break
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(i + 1),
+			strconv.FormatFloat(vertex[1], 'f', 6, 64),
+			strconv.FormatFloat(vertex[0], 'f', 6, 64),
+		})
+	}
+	l.section(l.t.Vertices, 40)
+	l.table([]reportColumn{{"#", 40, true}, {l.t.Latitude, 110, true}, {l.t.Longitude, 110, true}}, rows, 9)
+	if len(vertices) > reportMaxVertices {
+		l.paragraph(fmt.Sprintf(l.t.MoreVertices, len(vertices)-reportMaxVertices), 9, reportMuted)
+	}
+}
+
+func (l *reportLayout) gallery(images []entities.ReportImage) {
+	const (
+		gap         = 16.0
+		imageHeight = 170.0
+		cellHeight  = imageHeight + 36
+	)
+	l.section(l.t.Gallery, cellHeight)
+	if len(images) == 0 {
+		l.paragraph(l.t.NoImages, 10, reportMuted)
+		return
+	}
+
+	cellWidth := (reportContentWidth - gap) / 2
+	for i, image := range images {
+		column := i % 2
+		if column == 0 {
+			if i > 0 {
+				l.y += cellHeight
+			}
+			l.ensureSpace(cellHeight)
+		}
+		x := reportMargin + float64(column)*(cellWidth+gap)
+
+		l.color(reportLight)
+		l.doc.Rect(x, l.y, cellWidth, imageHeight, true)
+		if pdfImage, err := NewPDFImage(image.Data); err == nil {
+			w, h := fitReportImage(pdfImage, cellWidth, imageHeight)
+			l.doc.DrawImage(pdfImage, x+(cellWidth-w)/2, l.y+(imageHeight-h)/2, w, h)
+		} else {
+			l.color(reportMuted)
+			text := l.t.ImageUnavailable
+			l.doc.Text(x+(cellWidth-PDFTextWidth(text, 9, false))/2, l.y+imageHeight/2, 9, false, text)
+		}
+
+		caption := image.Media.Caption
+		if image.Media.CapturedAt != nil {
+			caption = strings.TrimSpace(caption + "  " + l.formatDate(*image.Media.CapturedAt, l.t.DateTimeLayout))
+		}
+		l.color(reportText)
+		for j, line := range PDFWrapText(caption, 9, false, cellWidth) {
+			if j == 2 {
+				break
+			}
+			l.doc.Text(x, l.y+imageHeight+13+float64(j)*12, 9, false, line)
+		}
+	}
+	l.y += cellHeight
+}
+
+// fitReportImage escala la imagen para que quepa en el recuadro sin deformarla
+func fitReportImage(img *PDFImage, maxWidth, maxHeight float64) (float64, float64) {
+	scale := math.Min(maxWidth/float64(img.Width), maxHeight/float64(img.Height))
+	return float64(img.Width) * scale, float64(img.Height) * scale
+}
+
+func (l *reportLayout) measurements(measurements []entities.Measurement, more bool) {
+	l.section(l.t.Measurements, 40)
+	if len(measurements) == 0 {
+		l.paragraph(l.t.NoMeasurements, 10, reportMuted)
+		return
+	}
+
+	columns := []reportColumn{
+		{"#", 26, true}, {l.t.Latitude, 66, true}, {l.t.Longitude, 66, true}, {l.t.Elevation, 48, true},
+		{l.t.Accuracy, 46, true}, {l.t.Instrument, 76, false}, {l.t.MeasuredAt, 72, false},
+	}
+	used := 0.0
+	for _, column := range columns {
+		used += column.width
+	}
+	columns = append(columns, reportColumn{l.t.Notes, reportContentWidth - used, false})
+
+	rows := make([][]string, len(measurements))
+	for i, m := range measurements {
+		rows[i] = []string{
+			strconv.Itoa(i + 1),
+			strconv.FormatFloat(m.Lat, 'f', 6, 64),
+			strconv.FormatFloat(m.Lng, 'f', 6, 64),
+			reportOptionalNumber(m.Elevation),
+			reportOptionalNumber(m.Accuracy),
+			m.Instrument,
+			l.formatDate(m.MeasuredAt, l.t.DateTimeLayout),
+			m.Notes,
+		}
+	}
+	l.table(columns, rows, 8)
+	if more {
+		l.y += 4
+		l.paragraph(fmt.Sprintf(l.t.MoreMeasurements, len(measurements)), 9, reportMuted)
+	}
+}
+
+func reportOptionalNumber(v *float64) string {
+	if v == nil {
+		return "-"
+	}
+	return strconv.FormatFloat(*v, 'f', 2, 64)
+}
+
+// reportColumn es una columna de las tablas del reporte
+type reportColumn struct {
+	title string
+	width float64
+	right bool
+}
+
+// table dibuja una tabla con filas alternadas; el encabezado se repite en cada página. Los textos que
+// no caben en su columna se recortan
+func (l *reportLayout) table(columns []reportColumn, rows [][]string, size float64) {
+	rowHeight := size + 6
+	drawRow := func(cells []string, bold bool) {
+		x := reportMargin
+		for i, column := range columns {
+			text := PDFTruncateText(cells[i], size, bold, column.width-8)
+			textX := x + 4
+			if column.right {
+				textX = x + column.width - 4 - PDFTextWidth(text, size, bold)
+			}
+			l.doc.Text(textX, l.y+rowHeight-4.5, size, bold, text)
+			x += column.width
+		}
+		l.y += rowHeight
+	}
+	header := func() {
+		titles := make([]string, len(columns))
+		width := 0.0
+		for i, column := range columns {
+			titles[i] = column.title
+			width += column.width
+		}
+		l.color(reportAccent)
+		l.doc.Rect(reportMargin, l.y, width, rowHeight, true)
+		l.doc.SetFillColor(1, 1, 1)
+		drawRow(titles, true)
+	}
+
+	l.ensureSpace(2 * rowHeight)
+	header()
+	for i, row := range rows {
+		if l.y+rowHeight > reportBottom {
+			l.ensureSpace(2 * rowHeight)
+			header()
+		}
+		if i%2 == 1 {
+			l.color(reportLight)
+			l.doc.Rect(reportMargin, l.y, reportContentWidth, rowHeight, true)
+		}
+		l.color(reportText)
+		drawRow(row, false)
+	}
+	l.y += 6
+}
+
+// footers agrega a cada página la fecha de generación y el número de página
+func (l *reportLayout) footers(generatedAt time.Time) {
+	total := l.doc.PageCount()
+	footer := fmt.Sprintf(l.t.Footer, l.formatDate(generatedAt, l.t.DateTimeLayout))
+	for i := 0; i < total; i++ {
+		l.doc.SetPage(i)
+		l.doc.SetStrokeColor(reportMuted[0], reportMuted[1], reportMuted[2])
+		l.doc.Line(reportMargin, PDFPageHeight-42, PDFPageWidth-reportMargin, PDFPageHeight-42, 0.5)
+		l.color(reportMuted)
+		l.doc.Text(reportMargin, PDFPageHeight-28, 8, false, footer)
+		page := fmt.Sprintf(l.t.Page, i+1, total)
+		l.doc.Text(PDFPageWidth-reportMargin-PDFTextWidth(page, 8, false), PDFPageHeight-28, 8, false, page)
+	}
+}
+
+func (l *reportLayout) formatDate(t time.Time, layout string) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.In(l.loc).Format(layout)
+}
+
+func reportAddress(address *entities.ProjectAddress) string {
+	if address == nil {
+		return ""
+	}
+	parts := make([]string, 0, 3)
+	for _, part := range []string{address.Municipality, address.State, address.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// drawReportSketch dibuja un croquis de la geometría, los puntos de medición y la ubicación del
+// proyecto en el recuadro indicado, proyectados a metros alrededor de su centro, con barra de escala
+// y flecha al norte
+func drawReportSketch(doc *PDFDocument, project entities.Project, measurements []entities.Measurement, x, y, w, h float64, emptyText string) {
+	doc.SetFillColor(reportLight[0], reportLight[1], reportLight[2])
+	doc.Rect(x, y, w, h, true)
+	doc.SetStrokeColor(reportMuted[0], reportMuted[1], reportMuted[2])
+	doc.Rect(x, y, w, h, false)
+
+	points := make([]entities.Position, 0, len(measurements)+1)
+	if project.Geometry != nil {
+		for _, ring := range project.Geometry.Coordinates {
+			points = append(points, ring...)
+		}
+	}
+	for _, m := range measurements {
+		points = append(points, entities.Position{m.Lng, m.Lat})
+	}
+	hasLocation := project.Lat != 0 || project.Lng != 0
+	if hasLocation {
+		points = append(points, entities.Position{project.Lng, project.Lat})
+	}
+	if len(points) == 0 {
+		doc.SetFillColor(reportMuted[0], reportMuted[1], reportMuted[2])
+		doc.Text(x+(w-PDFTextWidth(emptyText, 10, false))/2, y+h/2, 10, false, emptyText)
+		return
+	}
+
+	// Proyección equirectangular local: suficiente para la extensión de un levantamiento
+	minLng, maxLng, minLat, maxLat := points[0][0], points[0][0], points[0][1], points[0][1]
+	for _, p := range points {
+		minLng, maxLng = math.Min(minLng, p[0]), math.Max(maxLng, p[0])
+		minLat, maxLat = math.Min(minLat, p[1]), math.Max(maxLat, p[1])
+	}
+	centerLng, centerLat := (minLng+maxLng)/2, (minLat+maxLat)/2
+	metersX := 111320 * math.Cos(centerLat*math.Pi/180)
+	const metersY = 110540.0
+	spanX := math.Max((maxLng-minLng)*metersX, 1)
+	spanY := math.Max((maxLat-minLat)*metersY, 1)
+	if spanX < 50 && spanY < 50 {
+		// Un punto aislado se muestra con una extensión de 100 m
+		spanX, spanY = 100, 100
+	}
+	const padding = 24.0
+	scale := math.Min((w-2*padding)/spanX, (h-2*padding)/spanY)
+	toPage := func(p entities.Position) [2]float64 {
+		return [2]float64{
+			x + w/2 + (p[0]-centerLng)*metersX*scale,
+			y + h/2 - (p[1]-centerLat)*metersY*scale,
+		}
+	}
+
+	if project.Geometry != nil {
+		doc.SetStrokeColor(reportAccent[0], reportAccent[1], reportAccent[2])
+		doc.SetFillColor(0.78, 0.86, 0.93)
+		for _, ring := range project.Geometry.Coordinates {
+			page := make([][2]float64, len(ring))
+			for i, p := range ring {
+				page[i] = toPage(p)
+			}
+			polygon := project.Geometry.Type == entities.GeometryPolygon
+			doc.Polyline(page, polygon, polygon, 1.5)
+		}
+	}
+
+	doc.SetFillColor(reportText[0], reportText[1], reportText[2])
+	for _, m := range measurements {
+		p := toPage(entities.Position{m.Lng, m.Lat})
+		doc.Rect(p[0]-1.5, p[1]-1.5, 3, 3, true)
+	}
+	if hasLocation {
+		p := toPage(entities.Position{project.Lng, project.Lat})
+		doc.SetFillColor(0.8, 0.15, 0.15)
+		doc.Rect(p[0]-3.5, p[1]-3.5, 7, 7, true)
+	}
+
+	// Barra de escala de alrededor de la cuarta parte del ancho, redondeada a 1, 2 o 5 por potencia de 10
+	length := niceScaleLength(w / 4 / scale)
+	barWidth := length * scale
+	doc.SetStrokeColor(reportText[0], reportText[1], reportText[2])
+	doc.Line(x+12, y+h-12, x+12+barWidth, y+h-12, 1.5)
+	doc.SetFillColor(reportText[0], reportText[1], reportText[2])
+	doc.Text(x+12, y+h-17, 8, false, formatScaleLength(length))
+
+	// Flecha al norte
+	doc.Line(x+w-16, y+30, x+w-16, y+12, 1)
+	doc.Polyline([][2]float64{{x + w - 19, y + 16}, {x + w - 16, y + 10}, {x + w - 13, y + 16}}, true, true, 0.5)
+	doc.Text(x+w-19, y+40, 8, true, "N")
+}
+
+// niceScaleLength redondea hacia abajo a 1, 2 o 5 por una potencia de 10
+func niceScaleLength(meters float64) float64 {
+	if meters <= 0 {
+		return 1
+	}
+	power := math.Pow(10, math.Floor(math.Log10(meters)))
+	for _, step := range []float64{5, 2, 1} {
+		if step*power <= meters {
+			return step * power
+		}
+	}
+	return power
+}
+
+func formatScaleLength(meters float64) string {
+	if meters >= 1000 {
+		return strconv.FormatFloat(meters/1000, 'f', -1, 64) + " km"
+	}
+	return strconv.FormatFloat(meters, 'f', -1, 64) + " m"
+}
@@ -0,0 +1,285 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// maxStatsBuckets limita el número de periodos de una serie
+const maxStatsBuckets = 1000
+
+// statsTopUsers es cuántos usuarios se listan en los agregados de la organización
+const statsTopUsers = 10
+
+// defaultStatsPeriods es el número de periodos que se calculan si no se indica un rango
+var defaultStatsPeriods = map[string]int{
+	entities.StatsGranularityDay:   7,
+	entities.StatsGranularityWeek:  12,
+	entities.StatsGranularityMonth: 12,
+}
+
+// ParseStatsGranularity valida la granularidad de la serie; por defecto por día
+func ParseStatsGranularity(value string) (string, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return entities.StatsGranularityDay, nil
+	}
+	if _, ok := defaultStatsPeriods[value]; !ok {
+		return "", fmt.Errorf("%w: granularity debe ser day, week o month", entities.ErrInvalidFilter)
+	}
+	return value, nil
+}
+
+// StatsPeriodStart devuelve el inicio del periodo que contiene t en la zona horaria loc. Las semanas
+// empiezan en lunes, como las semanas ISO
+func StatsPeriodStart(t time.Time, granularity string, loc *time.Location) time.Time {
+	day := entities.StartOfDay(t, loc)
+	switch granularity {
+	case entities.StatsGranularityWeek:
+		// Weekday cuenta desde el domingo (0); el lunes queda en 0 días atrás
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case entities.StatsGranularityMonth:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, loc)
+	}
+	return day
+}
+
+// addStatsPeriods avanza (o retrocede con n negativo) n periodos desde el inicio de un periodo
+func addStatsPeriods(start time.Time, granularity string, n int) time.Time {
+	switch granularity {
+	case entities.StatsGranularityWeek:
+		return start.AddDate(0, 0, 7*n)
+	case entities.StatsGranularityMonth:
+		return start.AddDate(0, n, 0)
+	}
+	return start.AddDate(0, 0, n)
+}
+
+// AlignStatsRange ajusta el rango a periodos completos de la granularidad en loc. Sin fecha final el
+// rango termina con el periodo actual y sin fecha inicial abarca los periodos por defecto (7 días,
+// 12 semanas o 12 meses) hasta la fecha final
+func AlignStatsRange(dateRange entities.DateRange, granularity string, now time.Time, loc *time.Location) (entities.DateRange, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	var to time.Time
+	if dateRange.To.IsZero() {
+		to = addStatsPeriods(StatsPeriodStart(now, granularity, loc), granularity, 1)
+	} else {
+		// To es exclusivo: el último instante incluido determina el último periodo
+		to = addStatsPeriods(StatsPeriodStart(dateRange.To.Add(-time.Nanosecond), granularity, loc), granularity, 1)
+	}
+	var from time.Time
+	if dateRange.From.IsZero() {
+		from = addStatsPeriods(to, granularity, -defaultStatsPeriods[granularity])
+	} else {
+		from = StatsPeriodStart(dateRange.From, granularity, loc)
+	}
+
+	if !from.Before(to) {
+		return entities.DateRange{}, fmt.Errorf("%w: la fecha inicial es posterior a la final", entities.ErrInvalidFilter)
+	}
+	if countStatsPeriods(from, to, granularity) > maxStatsBuckets {
+		return entities.DateRange{}, fmt.Errorf("%w: el rango no puede tener más de %d periodos; use una granularidad mayor", entities.ErrInvalidFilter, maxStatsBuckets)
+	}
+	return entities.DateRange{From: from.UTC(), To: to.UTC()}, nil
+}
+
+// countStatsPeriods cuenta los periodos de [from, to), deteniéndose al pasar de maxStatsBuckets
+func countStatsPeriods(from, to time.Time, granularity string) int {
+	n := 0
+	for start := from; start.Before(to) && n <= maxStatsBuckets; start = addStatsPeriods(from, granularity, n) {
+		n++
+	}
+	return n
+}
+
+// StatsPreviousRange devuelve el rango inmediatamente anterior con el mismo número de periodos
+func StatsPreviousRange(query entities.ProjectStatsQuery) entities.DateRange {
+	from := query.Range.From.In(query.Location)
+	n := countStatsPeriods(from, query.Range.To.In(query.Location), query.Granularity)
+	return entities.DateRange{From: addStatsPeriods(from, query.Granularity, -n).UTC(), To: query.Range.From}
+}
+
+// ProjectStatsAggregator calcula las estadísticas a partir de los proyectos del rango y del rango
+// anterior, recibidos de uno en uno para no cargarlos todos en memoria
+type ProjectStatsAggregator struct {
+	query      entities.ProjectStatsQuery
+	previous   entities.DateRange
+	series     []entities.StatsBucket
+	prevSeries []entities.StatsBucket
+	index      map[int64]int
+	prevIndex  map[int64]int
+	categories map[int]*entities.CategoryStats
+	statuses   map[string]int
+	users      map[int]int
+	total      int
+	prevTotal  int
+}
+
+// NewProjectStatsAggregator prepara las series con todos los periodos en cero
+func NewProjectStatsAggregator(query entities.ProjectStatsQuery) *ProjectStatsAggregator {
+	if query.Location == nil {
+		query.Location = time.UTC
+	}
+	a := &ProjectStatsAggregator{
+		query:      query,
+		previous:   StatsPreviousRange(query),
+		categories: make(map[int]*entities.CategoryStats),
+		statuses:   make(map[string]int),
+		users:      make(map[int]int),
+	}
+	for _, status := range entities.ProjectStatuses {
+		a.statuses[status] = 0
+	}
+	a.series, a.index = a.buckets(query.Range)
+	a.prevSeries, a.prevIndex = a.buckets(a.previous)
+	return a
+}
+
+// PreviousRange es el rango anterior que también debe recibir Add para la comparación
+func (a *ProjectStatsAggregator) PreviousRange() entities.DateRange {
+	return a.previous
+}
+
+func (a *ProjectStatsAggregator) buckets(dateRange entities.DateRange) ([]entities.StatsBucket, map[int64]int) {
+	loc := a.query.Location
+	buckets := make([]entities.StatsBucket, 0)
+	index := make(map[int64]int)
+	to := dateRange.To.In(loc)
+	for start := dateRange.From.In(loc); start.Before(to); {
+		next := addStatsPeriods(start, a.query.Granularity, 1)
+		index[start.Unix()] = len(buckets)
+		buckets = append(buckets, entities.StatsBucket{
+			Period: statsPeriodLabel(start, a.query.Granularity),
+			From:   start.Format(entities.DateLayout),
+			To:     next.AddDate(0, 0, -1).Format(entities.DateLayout),
+		})
+		start = next
+	}
+	return buckets, index
+}
+
+// statsPeriodLabel es el nombre del periodo: la fecha, la semana ISO (2025-W46) o el mes (2025-11)
+func statsPeriodLabel(start time.Time, granularity string) string {
+	switch granularity {
+	case entities.StatsGranularityWeek:
+		year, week := start.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case entities.StatsGranularityMonth:
+		return start.Format("2006-01")
+	}
+	return start.Format(entities.DateLayout)
+}
+
+// Add cuenta un proyecto en el rango o en el rango anterior según su fecha; los que caen fuera de
+// ambos se ignoran
+func (a *ProjectStatsAggregator) Add(record entities.ProjectStatsRecord) {
+	start := StatsPeriodStart(record.Fecha, a.query.Granularity, a.query.Location).Unix()
+
+	if i, ok := a.prevIndex[start]; ok {
+		a.prevSeries[i].Count++
+		a.prevTotal++
+		a.category(record).PreviousCount++
+		return
+	}
+	i, ok := a.index[start]
+	if !ok {
+		return
+	}
+	a.series[i].Count++
+	a.total++
+	a.category(record).Count++
+	a.statuses[record.Status]++
+	a.users[record.UserId]++
+}
+
+func (a *ProjectStatsAggregator) category(record entities.ProjectStatsRecord) *entities.CategoryStats {
+	category, ok := a.categories[record.CategoryId]
+	if !ok {
+		category = &entities.CategoryStats{CategoryId: record.CategoryId, Categoria: record.Categoria}
+		a.categories[record.CategoryId] = category
+	}
+	return category
+}
+
+// Result devuelve las estadísticas calculadas. Las categorías se ordenan de la más a la menos usada
+func (a *ProjectStatsAggregator) Result() *entities.ProjectStats {
+	loc := a.query.Location
+	stats := &entities.ProjectStats{
+		Scope:       entities.StatsScopeOrganization,
+		UserId:      a.query.UserId,
+		Timezone:    loc.String(),
+		Granularity: a.query.Granularity,
+		From:        a.query.Range.From.In(loc).Format(entities.DateLayout),
+		To:          a.query.Range.To.In(loc).AddDate(0, 0, -1).Format(entities.DateLayout),
+		TotalCount:  a.total,
+		Series:      a.series,
+		ByCategory:  make([]entities.CategoryStats, 0, len(a.categories)),
+		ByStatus:    a.statuses,
+		Comparison: entities.StatsComparison{
+			From:           a.previous.From.In(loc).Format(entities.DateLayout),
+			To:             a.previous.To.In(loc).AddDate(0, 0, -1).Format(entities.DateLayout),
+			PreviousCount:  a.prevTotal,
+			Change:         a.total - a.prevTotal,
+			PreviousSeries: a.prevSeries,
+		},
+	}
+	if a.prevTotal > 0 {
+		change := roundStats(float64(a.total-a.prevTotal) * 100 / float64(a.prevTotal))
+		stats.Comparison.ChangePercent = &change
+	}
+
+	for _, category := range a.categories {
+		if a.total > 0 {
+			category.Share = roundStats(float64(category.Count) * 100 / float64(a.total))
+		}
+		stats.ByCategory = append(stats.ByCategory, *category)
+	}
+	sort.Slice(stats.ByCategory, func(i, j int) bool {
+		ci, cj := stats.ByCategory[i], stats.ByCategory[j]
+		if ci.Count != cj.Count {
+			return ci.Count > cj.Count
+		}
+		if ci.PreviousCount != cj.PreviousCount {
+			return ci.PreviousCount > cj.PreviousCount
+		}
+		return ci.Categoria < cj.Categoria
+	})
+
+	if a.query.UserId > 0 {
+		stats.Scope = entities.StatsScopeUser
+		return stats
+	}
+
+	organization := &entities.OrganizationStats{
+		ActiveUsers: len(a.users),
+		TopUsers:    make([]entities.UserProjectCount, 0, len(a.users)),
+	}
+	if len(a.users) > 0 {
+		organization.AveragePerUser = roundStats(float64(a.total) / float64(len(a.users)))
+	}
+	for userId, count := range a.users {
+		organization.TopUsers = append(organization.TopUsers, entities.UserProjectCount{UserId: userId, Count: count})
+	}
+	sort.Slice(organization.TopUsers, func(i, j int) bool {
+		ui, uj := organization.TopUsers[i], organization.TopUsers[j]
+		if ui.Count != uj.Count {
+			return ui.Count > uj.Count
+		}
+		return ui.UserId < uj.UserId
+	})
+	organization.TopUsers = organization.TopUsers[:min(len(organization.TopUsers), statsTopUsers)]
+	stats.Organization = organization
+	return stats
+}
+
+// roundStats redondea porcentajes y promedios a dos decimales
+func roundStats(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+const (
+	// MaxChecklistItems limita las tareas de la lista de verificación de un proyecto o plantilla
+	MaxChecklistItems = 100
+	// maxChecklistItemLength y maxTemplateNameLength coinciden con los tamaños que admite el esquema
+	maxChecklistItemLength = 200
+	maxTemplateNameLength  = 100
+)
+
+// ValidateProjectTemplate normaliza y valida una plantilla antes de guardarla. La categoría se
+// resuelve contra el catálogo aparte
+func ValidateProjectTemplate(template *entities.ProjectTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		return fmt.Errorf("%w: el nombre de la plantilla es obligatorio", entities.ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(template.Name) > maxTemplateNameLength {
+		return fmt.Errorf("%w: el nombre de la plantilla admite como máximo %d caracteres", entities.ErrInvalidInput, maxTemplateNameLength)
+	}
+
+	checklist := make([]string, 0, len(template.Checklist))
+	for _, item := range template.Checklist {
+		if item = strings.TrimSpace(item); item != "" {
+			checklist = append(checklist, item)
+		}
+	}
+	template.Checklist = checklist
+	return validateChecklistTexts(checklist)
+}
+
+// CanModifyTemplate aplica a las plantillas la regla de propiedad de los proyectos: solo su autor o un
+// administrador la modifica o la elimina
+func CanModifyTemplate(template entities.ProjectTemplate, userId int, isAdmin bool) bool {
+	return isAdmin || (userId > 0 && template.UserId == userId)
+}
+
+// ValidateChecklist verifica la lista de verificación de un proyecto
+func ValidateChecklist(checklist []entities.ChecklistItem) error {
+	texts := make([]string, 0, len(checklist))
+	for _, item := range checklist {
+		if strings.TrimSpace(item.Text) == "" {
+			return fmt.Errorf("%w: las tareas de la lista de verificación no pueden estar vacías", entities.ErrInvalidInput)
+		}
+		texts = append(texts, item.Text)
+	}
+	return validateChecklistTexts(texts)
+}
+
+func validateChecklistTexts(texts []string) error {
+	if len(texts) > MaxChecklistItems {
+		return fmt.Errorf("%w: la lista de verificación admite como máximo %d tareas", entities.ErrInvalidInput, MaxChecklistItems)
+	}
+	for _, text := range texts {
+		if utf8.RuneCountInString(text) > maxChecklistItemLength {
+			return fmt.Errorf("%w: cada tarea admite como máximo %d caracteres", entities.ErrInvalidInput, maxChecklistItemLength)
+		}
+	}
+	return nil
+}
+
+// ChecklistFromTemplate crea la lista de verificación de un proyecto nuevo, con todas las tareas pendientes
+func ChecklistFromTemplate(items []string) []entities.ChecklistItem {
+	checklist := make([]entities.ChecklistItem, 0, len(items))
+	for _, text := range items {
+		checklist = append(checklist, entities.ChecklistItem{Text: text})
+	}
+	return checklist
+}
+
+// checklistValue representa la lista de verificación para compararla en el historial
+func checklistValue(checklist []entities.ChecklistItem) string {
+	if len(checklist) == 0 {
+		return ""
+	}
+	raw, _ := json.Marshal(checklist)
+	return string(raw)
+}
@@ -0,0 +1,264 @@
+package services
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// utf8BOM hace que Excel abra el CSV como UTF-8 en lugar de la página de códigos del sistema
+const utf8BOM = "\uFEFF"
+
+// spreadsheetDateLayout es el formato de fecha del CSV, el que Excel reconoce sin configurar nada
+const spreadsheetDateLayout = "2006-01-02 15:04:05"
+
+// csvFormulaPrefixes son los caracteres con los que una hoja de cálculo interpreta un texto como fórmula
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// ProjectCSVWriter escribe los proyectos como CSV de forma incremental, una fila por proyecto
+type ProjectCSVWriter struct {
+	w       *csv.Writer
+	columns []ProjectColumn
+	loc     *time.Location
+	record  []string
+}
+
+// NewProjectCSVWriter escribe el BOM y la fila de encabezados en el idioma lang. Las fechas se escriben
+// en la zona horaria loc
+func NewProjectCSVWriter(w io.Writer, columns []ProjectColumn, lang string, loc *time.Location, delimiter rune) (*ProjectCSVWriter, error) {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return nil, err
+	}
+	c := &ProjectCSVWriter{w: csv.NewWriter(w), columns: columns, loc: loc, record: make([]string, len(columns))}
+	c.w.Comma = delimiter
+	c.w.UseCRLF = true
+
+	for i, column := range columns {
+		c.record[i] = column.Header(lang)
+	}
+	if err := c.w.Write(c.record); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// WriteProject agrega la fila de un proyecto. Los textos que empiezan como una fórmula se anteponen con
+// un apóstrofo para que la hoja de cálculo no los evalúe
+func (c *ProjectCSVWriter) WriteProject(project entities.Project) error {
+	for i, column := range c.columns {
+		switch v := column.Value(project).(type) {
+		case string:
+			if v != "" && strings.ContainsRune(csvFormulaPrefixes, rune(v[0])) {
+				v = "'" + v
+			}
+			c.record[i] = v
+		case int:
+			c.record[i] = strconv.Itoa(v)
+		case float64:
+			c.record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		case time.Time:
+			c.record[i] = ""
+			if !v.IsZero() {
+				c.record[i] = v.In(c.loc).Format(spreadsheetDateLayout)
+			}
+		default:
+			c.record[i] = fmt.Sprint(v)
+		}
+	}
+	return c.w.Write(c.record)
+}
+
+// Close envía las filas pendientes
+func (c *ProjectCSVWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// MaxXLSXRows es el máximo de filas de una hoja de Excel, encabezado incluido
+const MaxXLSXRows = 1048576
+
+// Estilos de celda definidos en xlsxStyles
+const (
+	xlsxStyleHeader = 1
+	xlsxStyleDate   = 2
+)
+
+// xlsxEpoch es el día cero de las fechas de Excel (sistema 1900)
+var xlsxEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+const xlsxContentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const xlsxRootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbookRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+// xlsxStyles define tres formatos de celda: normal, encabezado en negritas y fecha
+const xlsxStyles = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="3"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs>` +
+	`</styleSheet>`
+
+// ProjectXLSXWriter escribe un libro de Excel con una hoja de proyectos de forma incremental. Las
+// partes fijas del paquete se escriben al inicio y la hoja se comprime mientras se generan las filas;
+// los textos van como cadenas en línea para no tener que reunir todos los valores en sharedStrings
+type ProjectXLSXWriter struct {
+	archive *zip.Writer
+	sheet   *bufio.Writer
+	columns []ProjectColumn
+	loc     *time.Location
+	rows    int
+}
+
+// NewProjectXLSXWriter escribe la estructura del libro y la fila de encabezados en el idioma lang.
+// Las fechas se convierten a la zona horaria loc porque Excel no guarda zona horaria
+func NewProjectXLSXWriter(w io.Writer, columns []ProjectColumn, lang string, loc *time.Location) (*ProjectXLSXWriter, error) {
+	archive := zip.NewWriter(w)
+	workbook := xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="` + xlsxSheetName(lang) + `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+	for _, part := range []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", workbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+	} {
+		f, err := archive.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	x := &ProjectXLSXWriter{archive: archive, sheet: bufio.NewWriter(f), columns: columns, loc: loc}
+	// La primera fila queda fija al desplazarse por la hoja
+	x.sheet.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>` +
+		`<sheetData>`)
+
+	x.startRow()
+	for i, column := range columns {
+		x.writeText(i, column.Header(lang), xlsxStyleHeader)
+	}
+	x.sheet.WriteString(`</row>`)
+	return x, nil
+}
+
+func xlsxSheetName(lang string) string {
+	if lang == "en" {
+		return "Projects"
+	}
+	return "Proyectos"
+}
+
+// WriteProject agrega la fila de un proyecto; falla si la hoja ya alcanzó el máximo de filas de Excel
+func (x *ProjectXLSXWriter) WriteProject(project entities.Project) error {
+	if x.rows >= MaxXLSXRows {
+		return fmt.Errorf("%w: la exportación supera las %d filas que admite Excel; use CSV o más filtros", entities.ErrInvalidFilter, MaxXLSXRows)
+	}
+	x.startRow()
+	for i, column := range x.columns {
+		value := column.Value(project)
+		if isZeroTime(value) {
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			if v != "" {
+				x.writeText(i, v, 0)
+			}
+		case int:
+			x.writeNumber(i, strconv.Itoa(v), 0)
+		case float64:
+			x.writeNumber(i, strconv.FormatFloat(v, 'f', -1, 64), 0)
+		case time.Time:
+			x.writeNumber(i, strconv.FormatFloat(xlsxSerial(v.In(x.loc)), 'f', -1, 64), xlsxStyleDate)
+		default:
+			x.writeText(i, fmt.Sprint(v), 0)
+		}
+	}
+	_, err := x.sheet.WriteString(`</row>`)
+	return err
+}
+
+// Close agrega el autofiltro sobre las filas escritas y cierra el paquete
+func (x *ProjectXLSXWriter) Close() error {
+	lastColumn := xlsxColumnName(len(x.columns) - 1)
+	x.sheet.WriteString(`</sheetData><autoFilter ref="A1:` + lastColumn + strconv.Itoa(x.rows) + `"/></worksheet>`)
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	return x.archive.Close()
+}
+
+func (x *ProjectXLSXWriter) startRow() {
+	x.rows++
+	x.sheet.WriteString(`<row r="` + strconv.Itoa(x.rows) + `">`)
+}
+
+func (x *ProjectXLSXWriter) cellStart(column, style int) {
+	x.sheet.WriteString(`<c r="` + xlsxColumnName(column) + strconv.Itoa(x.rows) + `"`)
+	if style > 0 {
+		x.sheet.WriteString(` s="` + strconv.Itoa(style) + `"`)
+	}
+}
+
+func (x *ProjectXLSXWriter) writeText(column int, text string, style int) {
+	x.cellStart(column, style)
+	x.sheet.WriteString(` t="inlineStr"><is><t xml:space="preserve">`)
+	// EscapeText reemplaza los caracteres que XML no admite, como los de control
+	xml.EscapeText(x.sheet, []byte(text))
+	x.sheet.WriteString(`</t></is></c>`)
+}
+
+func (x *ProjectXLSXWriter) writeNumber(column int, number string, style int) {
+	x.cellStart(column, style)
+	x.sheet.WriteString(`><v>` + number + `</v></c>`)
+}
+
+// xlsxColumnName convierte el índice de columna (desde 0) en su nombre: A, B, ..., Z, AA, AB...
+func xlsxColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+// xlsxSerial convierte la hora local de t en el número de serie de fecha de Excel
+func xlsxSerial(t time.Time) float64 {
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	return wall.Sub(xlsxEpoch).Hours() / 24
+}
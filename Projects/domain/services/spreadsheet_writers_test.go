@@ -0,0 +1,91 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+func TestSpreadsheetExport(t *testing.T) {
+	columns, err := ParseProjectColumns("id, nombre,fecha,lat,nombre")
+	if err != nil || len(columns) != 4 {
+		t.Fatalf("columnas inesperadas: %d (%v)", len(columns), err)
+	}
+	if _, err := ParseProjectColumns("id,password"); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("se esperaba rechazar una columna desconocida, obtenido %v", err)
+	}
+	if _, err := ParseExportLanguage("fr"); !errors.Is(err, entities.ErrInvalidFilter) {
+		t.Errorf("se esperaba rechazar el idioma fr, obtenido %v", err)
+	}
+
+	loc, _ := time.LoadLocation("America/Mexico_City")
+	project := entities.Project{
+		Id:             5,
+		NombreProyecto: "=HYPERLINK(\"x\"); Predio Ñandú",
+		Fecha:          time.Date(2025, 3, 1, 18, 30, 0, 0, time.UTC),
+		Lat:            19.4326,
+	}
+
+	var csvOut strings.Builder
+	csvWriter, err := NewProjectCSVWriter(&csvOut, columns, "en", loc, ';')
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if err := csvWriter.WriteProject(project); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	csvWriter.Close()
+	expected := "\uFEFFID;Name;Date;Latitude\r\n5;\"'=HYPERLINK(\"\"x\"\"); Predio Ñandú\";2025-03-01 12:30:00;19.4326\r\n"
+	if csvOut.String() != expected {
+		t.Errorf("CSV inesperado:\n%q\nse esperaba\n%q", csvOut.String(), expected)
+	}
+
+	var xlsxOut bytes.Buffer
+	xlsxWriter, err := NewProjectXLSXWriter(&xlsxOut, columns, "es", loc)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	project.NombreProyecto = "Predio <norte> & sur"
+	if err := xlsxWriter.WriteProject(project); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if err := xlsxWriter.Close(); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(xlsxOut.Bytes()), int64(xlsxOut.Len()))
+	if err != nil {
+		t.Fatalf("el XLSX no es un zip válido: %v", err)
+	}
+	parts := make(map[string]string)
+	for _, f := range archive.File {
+		rc, _ := f.Open()
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		parts[f.Name] = string(data)
+		if err := xml.Unmarshal(data, new(interface{})); err != nil {
+			t.Errorf("la parte %s no es XML válido: %v", f.Name, err)
+		}
+	}
+	sheet, ok := parts["xl/worksheets/sheet1.xml"]
+	if !ok || parts["[Content_Types].xml"] == "" || parts["xl/styles.xml"] == "" {
+		t.Fatalf("faltan partes del libro: %v", len(parts))
+	}
+	for _, fragment := range []string{
+		`<c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">ID</t></is></c>`,
+		`<c r="B2" t="inlineStr"><is><t xml:space="preserve">Predio &lt;norte&gt; &amp; sur</t></is></c>`,
+		`<c r="C2" s="2"><v>45717.520833333336</v></c>`,
+		`<autoFilter ref="A1:D2"/>`,
+	} {
+		if !strings.Contains(sheet, fragment) {
+			t.Errorf("la hoja no contiene %s", fragment)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// MaxStatusCommentLength coincide con el tamaño de la columna comment
+const MaxStatusCommentLength = 1000
+
+// DefaultProjectStatusWorkflow es el flujo habitual de un levantamiento: se planea, se trabaja en campo,
+// se procesa y se entrega. Cada paso puede volver al anterior para corregir y un proyecto archivado
+// solo se reabre como borrador
+func DefaultProjectStatusWorkflow() entities.ProjectStatusWorkflow {
+	return entities.ProjectStatusWorkflow{
+		Statuses: slices.Clone(entities.ProjectStatuses),
+		Transitions: map[string][]string{
+			entities.StatusDraft:      {entities.StatusPlanned, entities.StatusArchived},
+			entities.StatusPlanned:    {entities.StatusInField, entities.StatusDraft, entities.StatusArchived},
+			entities.StatusInField:    {entities.StatusProcessing, entities.StatusPlanned},
+			entities.StatusProcessing: {entities.StatusDelivered, entities.StatusInField},
+			entities.StatusDelivered:  {entities.StatusArchived, entities.StatusProcessing},
+			entities.StatusArchived:   {entities.StatusDraft},
+		},
+	}
+}
+
+// ParseProjectStatusWorkflow lee un flujo con el formato "draft:planned,archived;planned:in_field,draft".
+// Los estados que no aparecen a la izquierda no tienen salida
+func ParseProjectStatusWorkflow(spec string) (entities.ProjectStatusWorkflow, error) {
+	workflow := entities.ProjectStatusWorkflow{
+		Statuses:    slices.Clone(entities.ProjectStatuses),
+		Transitions: make(map[string][]string),
+	}
+	for _, rule := range strings.Split(spec, ";") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		from, targets, ok := strings.Cut(rule, ":")
+		from = strings.TrimSpace(from)
+		if !ok || !IsProjectStatus(from) {
+			return workflow, fmt.Errorf("regla de estados inválida %q", rule)
+		}
+		if _, repeated := workflow.Transitions[from]; repeated {
+			return workflow, fmt.Errorf("el estado %q aparece en más de una regla", from)
+		}
+		allowed := []string{}
+		for _, to := range strings.Split(targets, ",") {
+			to = strings.TrimSpace(to)
+			if !IsProjectStatus(to) || to == from {
+				return workflow, fmt.Errorf("destino %q inválido en la regla de %q", to, from)
+			}
+			allowed = append(allowed, to)
+		}
+		workflow.Transitions[from] = allowed
+	}
+	if len(workflow.Transitions) == 0 {
+		return workflow, fmt.Errorf("el flujo de estados no tiene reglas")
+	}
+	return workflow, nil
+}
+
+// IsProjectStatus indica si status es uno de los estados conocidos
+func IsProjectStatus(status string) bool {
+	return slices.Contains(entities.ProjectStatuses, status)
+}
+
+// CheckStatusTransition valida el paso de from a to según el flujo. Un estado desconocido es
+// ErrInvalidInput; una transición no permitida es ErrConflict e indica a qué estados se puede pasar
+func CheckStatusTransition(workflow entities.ProjectStatusWorkflow, from, to string) error {
+	if !IsProjectStatus(to) {
+		return fmt.Errorf("%w: estado %q desconocido; use uno de %s", entities.ErrInvalidInput, to, strings.Join(entities.ProjectStatuses, ", "))
+	}
+	if from == to {
+		return fmt.Errorf("%w: el proyecto ya está en estado %q", entities.ErrConflict, to)
+	}
+	allowed := workflow.Transitions[from]
+	if !slices.Contains(allowed, to) {
+		if len(allowed) == 0 {
+			return fmt.Errorf("%w: el estado %q no admite transiciones", entities.ErrConflict, from)
+		}
+		return fmt.Errorf("%w: no se puede pasar de %q a %q; estados permitidos: %s", entities.ErrConflict, from, to, strings.Join(allowed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+const (
+	// maxTagLength coincide con el tamaño de la columna nombre de tags
+	maxTagLength = 50
+	// MaxTagsPerRequest limita las etiquetas que se asignan o filtran en una sola petición
+	MaxTagsPerRequest = 20
+)
+
+// NormalizeTags limpia los nombres de etiquetas recibidos, deriva su slug y descarta repetidos
+// ("Temporada Seca" y "temporada seca" son la misma etiqueta; se conserva el primer nombre)
+func NormalizeTags(names []string) ([]entities.Tag, error) {
+	tags := make([]entities.Tag, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.Join(strings.Fields(name), " ")
+		if name == "" {
+			continue
+		}
+		if utf8.RuneCountInString(name) > maxTagLength {
+			return nil, fmt.Errorf("%w: la etiqueta %q supera los %d caracteres", entities.ErrInvalidInput, name, maxTagLength)
+		}
+		slug := Slugify(name)
+		if slug == "" {
+			return nil, fmt.Errorf("%w: la etiqueta %q no contiene letras ni números", entities.ErrInvalidInput, name)
+		}
+		if seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		tags = append(tags, entities.Tag{Slug: slug, Nombre: name})
+	}
+	if len(tags) > MaxTagsPerRequest {
+		return nil, fmt.Errorf("%w: se permiten como máximo %d etiquetas por petición", entities.ErrInvalidInput, MaxTagsPerRequest)
+	}
+	return tags, nil
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// accentFolding reemplaza letras acentuadas por su forma base para comparar sin acentos
+var accentFolding = map[rune]rune{
+	'á': 'a', 'à': 'a', 'ä': 'a', 'â': 'a', 'ã': 'a',
+	'é': 'e', 'è': 'e', 'ë': 'e', 'ê': 'e',
+	'í': 'i', 'ì': 'i', 'ï': 'i', 'î': 'i',
+	'ó': 'o', 'ò': 'o', 'ö': 'o', 'ô': 'o', 'õ': 'o',
+	'ú': 'u', 'ù': 'u', 'ü': 'u', 'û': 'u',
+	'ñ': 'n', 'ç': 'c',
+}
+
+// spanishStopwords son palabras demasiado frecuentes para aportar relevancia
+var spanishStopwords = map[string]bool{
+	"a": true, "al": true, "con": true, "de": true, "del": true, "el": true,
+	"en": true, "es": true, "la": true, "las": true, "lo": true, "los": true,
+	"o": true, "para": true, "por": true, "que": true, "se": true, "su": true,
+	"un": true, "una": true, "y": true,
+}
+
+// NormalizeText convierte el texto a minúsculas y elimina los acentos
+func NormalizeText(text string) string {
+	var b strings.Builder
+	b.Grow(len(text))
+	for _, r := range strings.ToLower(text) {
+		if folded, ok := accentFolding[r]; ok {
+			r = folded
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// isWordRune indica si el carácter forma parte de una palabra
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Tokenize separa el texto en términos normalizados descartando palabras vacías
+func Tokenize(text string) []string {
+	words := strings.FieldsFunc(NormalizeText(text), func(r rune) bool { return !isWordRune(r) })
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if spanishStopwords[word] {
+			continue
+		}
+		terms = append(terms, word)
+	}
+	return terms
+}
+
+// TypoTolerance es la distancia de edición máxima aceptada para un término
+func TypoTolerance(term string) int {
+	switch n := len([]rune(term)); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// EditDistance calcula la distancia de Levenshtein entre dos palabras
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// MatchTerm compara una palabra normalizada con un término de búsqueda y devuelve
+// un peso: 1 para coincidencia exacta, menor para prefijos y errores tipográficos, 0 si no coincide
+func MatchTerm(word, term string) float64 {
+	if word == term {
+		return 1
+	}
+	if len(term) >= 3 && strings.HasPrefix(word, term) {
+		return 0.8
+	}
+	if tolerance := TypoTolerance(term); tolerance > 0 {
+		// Compara también contra el prefijo de la palabra para tolerar errores en términos parciales
+		candidate := word
+		if runes := []rune(word); len(runes) > len([]rune(term))+tolerance {
+			candidate = string(runes[:len([]rune(term))])
+		}
+		if EditDistance(candidate, term) <= tolerance {
+			return 0.6
+		}
+	}
+	return 0
+}
+
+// HighlightTerms marca con <mark> las palabras del texto que coinciden con algún término. El resultado es
+// HTML: el resto del texto se escapa para que un cliente pueda mostrarlo sin interpretar marcado del usuario.
+// Si maxWords es mayor a 0 devuelve solo un fragmento de ese tamaño alrededor de la primera coincidencia
+func HighlightTerms(text string, terms []string, maxWords int) (string, bool) {
+	type span struct{ start, end int }
+
+	var words []span
+	start := -1
+	for i, r := range text {
+		if isWordRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			words = append(words, span{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, span{start, len(text)})
+	}
+
+	matched := make([]bool, len(words))
+	first := -1
+	for i, w := range words {
+		normalized := NormalizeText(text[w.start:w.end])
+		for _, term := range terms {
+			if MatchTerm(normalized, term) > 0 {
+				matched[i] = true
+				if first < 0 {
+					first = i
+				}
+				break
+			}
+		}
+	}
+	if first < 0 {
+		if maxWords > 0 && len(words) > maxWords {
+			return html.EscapeString(strings.TrimSpace(text[:words[maxWords-1].end])) + "…", false
+		}
+		return html.EscapeString(text), false
+	}
+
+	from, to := 0, len(words)
+	if maxWords > 0 && len(words) > maxWords {
+		from = max(0, first-maxWords/2)
+		to = min(len(words), from+maxWords)
+		from = max(0, to-maxWords)
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	cursor := words[from].start
+	if from == 0 {
+		cursor = 0
+	}
+	for i := from; i < to; i++ {
+		w := words[i]
+		b.WriteString(html.EscapeString(text[cursor:w.start]))
+		if matched[i] {
+			b.WriteString("<mark>" + html.EscapeString(text[w.start:w.end]) + "</mark>")
+		} else {
+			b.WriteString(html.EscapeString(text[w.start:w.end]))
+		}
+		cursor = w.end
+	}
+	if to == len(words) {
+		b.WriteString(html.EscapeString(text[cursor:]))
+	} else {
+		b.WriteString("…")
+	}
+	return b.String(), true
+}
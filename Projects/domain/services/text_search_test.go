@@ -0,0 +1,23 @@
+package services
+
+import "testing"
+
+func TestHighlightTerms_EscapesMarkup(t *testing.T) {
+	terms := Tokenize("casa")
+
+	got, ok := HighlightTerms(`<script>x</script> casa & "patio"`, terms, 0)
+	want := `&lt;script&gt;x&lt;/script&gt; <mark>casa</mark> &amp; &#34;patio&#34;`
+	if !ok || got != want {
+		t.Errorf("HighlightTerms = %q, se esperaba %q", got, want)
+	}
+
+	// El fragmento alrededor de la coincidencia también se escapa
+	got, ok = HighlightTerms(`<b>uno</b> dos tres cuatro casa <img src=x onerror=alert(1)>`, terms, 3)
+	if !ok || got != `…cuatro <mark>casa</mark> &lt;img…` {
+		t.Errorf("fragmento inesperado: %q", got)
+	}
+
+	if got, ok := HighlightTerms(`<i>sin coincidencias</i>`, terms, 0); ok || got != `&lt;i&gt;sin coincidencias&lt;/i&gt;` {
+		t.Errorf("texto sin coincidencias no escapado: %q (%t)", got, ok)
+	}
+}
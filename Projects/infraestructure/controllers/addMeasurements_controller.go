@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+// measurementsBodyLimit acota el cuerpo de un lote de puntos (8 MB)
+const measurementsBodyLimit = 8 << 20
+
+type AddMeasurementsController struct {
+	useCase *application.AddMeasurementsUseCase
+}
+
+func NewAddMeasurementsController(useCase *application.AddMeasurementsUseCase) *AddMeasurementsController {
+	return &AddMeasurementsController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/:id/measurements con {"points": [{lat, lng, elevation?, measured_at, instrument?, accuracy?, notes?}]}
+func (c *AddMeasurementsController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, measurementsBodyLimit)
+	var body struct {
+		Points []entities.Measurement `json:"points"`
+	}
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+		return
+	}
+
+	pointCount, err := c.useCase.Execute(id, body.Points)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al guardar los puntos")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"success":     true,
+		"inserted":    len(body.Points),
+		"point_count": pointCount,
+	})
+}
@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type AddProjectTagsController struct {
+	useCase *application.AddProjectTagsUseCase
+}
+
+func NewAddProjectTagsController(useCase *application.AddProjectTagsUseCase) *AddProjectTagsController {
+	return &AddProjectTagsController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/:id/tags con {"tags": ["Cliente ACME", "Temporada seca"]}
+func (c *AddProjectTagsController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	var body struct {
+		Tags []string `json:"tags"`
+	}
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+		return
+	}
+
+	tags, err := c.useCase.Execute(id, body.Tags)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al asignar las etiquetas")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    tags,
+	})
+}
@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type AutocompleteTagsController struct {
+	useCase *application.AutocompleteTagsUseCase
+}
+
+func NewAutocompleteTagsController(useCase *application.AutocompleteTagsUseCase) *AutocompleteTagsController {
+	return &AutocompleteTagsController{useCase: useCase}
+}
+
+// Execute maneja GET /tags?q=tempo&limit=10
+func (c *AutocompleteTagsController) Execute(ctx *gin.Context) {
+	limit, err := queryInt(ctx, "limit")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	tags, err := c.useCase.Execute(ctx.Query("q"), limit)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al consultar las etiquetas")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    tags,
+	})
+}
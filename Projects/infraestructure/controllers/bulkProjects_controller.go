@@ -0,0 +1,42 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type BulkProjectsController struct {
+	useCase *application.BulkProjectsUseCase
+}
+
+func NewBulkProjectsController(useCase *application.BulkProjectsUseCase) *BulkProjectsController {
+	return &BulkProjectsController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/bulk con {"action": "...", "ids": [...]} y los campos de la acción.
+// Responde 200 si se aplicó a todos los proyectos y 422 con el resultado de cada uno si no se aplicó
+func (c *BulkProjectsController) Execute(ctx *gin.Context) {
+	var op entities.BulkOperation
+	if err := ctx.ShouldBindJSON(&op); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+		return
+	}
+
+	report, err := c.useCase.Execute(op, tokenUserId(ctx), requestIsAdmin(ctx))
+	if err != nil {
+		respondQueryError(ctx, err, "Error en la operación masiva")
+		return
+	}
+
+	status := http.StatusOK
+	if !report.Applied {
+		status = http.StatusUnprocessableEntity
+	}
+	ctx.JSON(status, gin.H{
+		"success": report.Applied,
+		"data":    report,
+	})
+}
@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type CloneProjectController struct {
+	useCase *application.CloneProjectUseCase
+}
+
+func NewCloneProjectController(useCase *application.CloneProjectUseCase) *CloneProjectController {
+	return &CloneProjectController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/:id/clone con un cuerpo opcional {"nombreProyecto": "...", "includeImages": true}
+func (c *CloneProjectController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	var body struct {
+		NombreProyecto string `json:"nombreProyecto"`
+		IncludeImages  bool   `json:"includeImages"`
+	}
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+			return
+		}
+	}
+
+	project, err := c.useCase.Execute(id, entities.CloneOptions{
+		NombreProyecto: strings.TrimSpace(body.NombreProyecto),
+		IncludeImages:  body.IncludeImages,
+		UserId:         tokenUserId(ctx),
+	})
+	if err != nil {
+		respondQueryError(ctx, err, "Error al clonar el proyecto")
+		return
+	}
+
+	respondProject(ctx, http.StatusCreated, project, "Proyecto clonado exitosamente")
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type CreateCategoryController struct {
+	useCase *application.CreateCategoryUseCase
+}
+
+func NewCreateCategoryController(useCase *application.CreateCategoryUseCase) *CreateCategoryController {
+	return &CreateCategoryController{useCase: useCase}
+}
+
+// Execute maneja POST /categories con {nombre, slug?, color?, icon?}
+func (c *CreateCategoryController) Execute(ctx *gin.Context) {
+	var category entities.Category
+	if err := ctx.ShouldBindJSON(&category); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+		return
+	}
+
+	created, err := c.useCase.Execute(category)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al crear la categoría")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    created,
+	})
+}
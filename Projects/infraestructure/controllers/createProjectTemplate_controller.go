@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type CreateProjectTemplateController struct {
+	useCase *application.CreateProjectTemplateUseCase
+}
+
+func NewCreateProjectTemplateController(useCase *application.CreateProjectTemplateUseCase) *CreateProjectTemplateController {
+	return &CreateProjectTemplateController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/templates con {name, category_id o categoria, descripcion, checklist}
+func (c *CreateProjectTemplateController) Execute(ctx *gin.Context) {
+	var template entities.ProjectTemplate
+	if err := ctx.ShouldBindJSON(&template); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+		return
+	}
+	template.UserId = tokenUserId(ctx)
+
+	created, err := c.useCase.Execute(template)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al crear la plantilla")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    created,
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
 	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
 )
 
 // CreateProjectController
@@ -37,7 +38,17 @@ func (c *CreateProjectController) Execute(ctx *gin.Context) {
 
 	
 	project.NombreProyecto = ctx.PostForm("nombreProyecto")
-	project.Fecha = ctx.PostForm("fecha")
+	loc, err := entities.LoadTimezone(ctx.PostForm("tz"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	fecha, err := entities.ParseProjectDate(ctx.PostForm("fecha"), loc)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	project.Fecha = fecha
 	project.Categoria = ctx.PostForm("categoria")
 	project.Descripcion = ctx.PostForm("descripcion")
 
@@ -46,7 +57,25 @@ func (c *CreateProjectController) Execute(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "El nombre del proyecto es obligatorio"})
 		return
 	}
-	if project.Categoria == "" {
+	// La categoría se indica por su id en el catálogo o por su nombre o slug
+	if categoryIdStr := ctx.PostForm("categoryId"); categoryIdStr != "" {
+		categoryId, err := strconv.Atoi(categoryIdStr)
+		if err != nil || categoryId <= 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "El categoryId debe ser un número mayor a 0"})
+			return
+		}
+		project.CategoryId = categoryId
+	}
+	// Una plantilla aporta la categoría, el esqueleto de la descripción y la lista de verificación
+	if templateIdStr := ctx.PostForm("templateId"); templateIdStr != "" {
+		templateId, err := strconv.Atoi(templateIdStr)
+		if err != nil || templateId <= 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "El templateId debe ser un número mayor a 0"})
+			return
+		}
+		project.TemplateId = templateId
+	}
+	if project.Categoria == "" && project.CategoryId == 0 && project.TemplateId == 0 {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "La categoría es obligatoria"})
 		return
 	}
@@ -94,6 +123,27 @@ func (c *CreateProjectController) Execute(ctx *gin.Context) {
 		project.Lng = lng
 	}
 
+	// Coordenadas x/y y geometría en otro sistema de referencia (crs=EPSG:32614); se reproyectan a WGS84
+	crs, err := inputCRS(ctx)
+	if err != nil {
+		respondQueryError(ctx, err, "Sistema de referencia inválido")
+		return
+	}
+	if err := readProjectedLocation(ctx, crs, &project); err != nil {
+		respondQueryError(ctx, err, "Coordenadas inválidas")
+		return
+	}
+
+	// Área levantada (opcional): polígono o línea en GeoJSON o WKT; su centroide reemplaza lat/lng
+	if geometryStr := ctx.PostForm("geometry"); geometryStr != "" {
+		geometry, err := services.ParseGeometryInCRS(geometryStr, crs)
+		if err != nil {
+			respondQueryError(ctx, err, "Geometría inválida")
+			return
+		}
+		project.Geometry = geometry
+	}
+
 	// Modo offline
 	var imagePath string
 	file, err := ctx.FormFile("img")
@@ -114,24 +164,32 @@ func (c *CreateProjectController) Execute(ctx *gin.Context) {
 		fmt.Printf("DEBUG: Imagen temporal guardada: %s\n", imagePath)
 	}
 
+	// Galería: el campo media puede repetirse para subir varias imágenes
+	media, err := saveMediaUploads(ctx, loc)
+	if err != nil {
+		if imagePath != "" {
+			os.Remove(imagePath)
+		}
+		respondQueryError(ctx, err, "Error al guardar las imágenes temporales")
+		return
+	}
+
 	
 	fmt.Printf("DEBUG: Proyecto completo antes del use case: %+v\n", project)
 	fmt.Printf("DEBUG: Ruta de imagen: %s\n", imagePath)
 
 	
-	result, err := c.useCase.Execute(project, imagePath)
+	result, err := c.useCase.Execute(project, imagePath, media)
 	
 	
 	if imagePath != "" {
 		os.Remove(imagePath)
 		fmt.Printf("DEBUG: Archivo temporal eliminado: %s\n", imagePath)
 	}
+	removeMediaUploads(media)
 
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Error al crear el proyecto: " + err.Error(),
-			"success": false,
-		})
+		respondQueryError(ctx, err, "Error al crear el proyecto")
 		return
 	}
 
@@ -142,6 +200,7 @@ func (c *CreateProjectController) Execute(ctx *gin.Context) {
 		"message":    result.Message,
 		"is_offline": result.IsOffline,
 		"has_image":  result.HasImage,
+		"project_id": result.ProjectId,
 	}
 
 	
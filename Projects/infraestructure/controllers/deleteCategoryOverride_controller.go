@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteCategoryOverrideController struct {
+	useCase *application.DeleteCategoryOverrideUseCase
+}
+
+func NewDeleteCategoryOverrideController(useCase *application.DeleteCategoryOverrideUseCase) *DeleteCategoryOverrideController {
+	return &DeleteCategoryOverrideController{useCase: useCase}
+}
+
+// Execute maneja DELETE /categories/:id/overrides/:orgId
+func (c *DeleteCategoryOverrideController) Execute(ctx *gin.Context) {
+	categoryId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	orgId, err := strconv.Atoi(ctx.Param("orgId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "orgId inválido", "success": false})
+		return
+	}
+
+	if err := c.useCase.Execute(categoryId, orgId); err != nil {
+		respondQueryError(ctx, err, "Error al eliminar la personalización")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true, "message": "Personalización eliminada correctamente"})
+}
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteCategoryController struct {
+	useCase *application.DeleteCategoryUseCase
+}
+
+func NewDeleteCategoryController(useCase *application.DeleteCategoryUseCase) *DeleteCategoryController {
+	return &DeleteCategoryController{useCase: useCase}
+}
+
+// Execute maneja DELETE /categories/:id
+func (c *DeleteCategoryController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	if err := c.useCase.Execute(id); err != nil {
+		respondQueryError(ctx, err, "Error al eliminar la categoría")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true, "message": "Categoría eliminada correctamente"})
+}
@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteMeasurementController struct {
+	useCase *application.DeleteMeasurementUseCase
+}
+
+func NewDeleteMeasurementController(useCase *application.DeleteMeasurementUseCase) *DeleteMeasurementController {
+	return &DeleteMeasurementController{useCase: useCase}
+}
+
+// Execute maneja DELETE /projects/:id/measurements/:measurementId
+func (c *DeleteMeasurementController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	measurementId, err := strconv.Atoi(ctx.Param("measurementId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID de punto inválido", "success": false})
+		return
+	}
+
+	if err := c.useCase.Execute(id, measurementId); err != nil {
+		respondQueryError(ctx, err, "Error al eliminar el punto")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true, "message": "Punto eliminado correctamente"})
+}
@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteProjectDocumentController struct {
+	useCase *application.DeleteProjectDocumentUseCase
+}
+
+func NewDeleteProjectDocumentController(useCase *application.DeleteProjectDocumentUseCase) *DeleteProjectDocumentController {
+	return &DeleteProjectDocumentController{useCase: useCase}
+}
+
+// Execute maneja DELETE /projects/:id/documents/:documentId
+func (c *DeleteProjectDocumentController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	documentId, err := strconv.Atoi(ctx.Param("documentId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID de documento inválido", "success": false})
+		return
+	}
+
+	if err := c.useCase.Execute(id, documentId); err != nil {
+		respondQueryError(ctx, err, "Error al eliminar el documento")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true, "message": "Documento eliminado correctamente"})
+}
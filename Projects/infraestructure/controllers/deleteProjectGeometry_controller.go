@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteProjectGeometryController struct {
+	useCase *application.SetProjectGeometryUseCase
+}
+
+func NewDeleteProjectGeometryController(useCase *application.SetProjectGeometryUseCase) *DeleteProjectGeometryController {
+	return &DeleteProjectGeometryController{useCase: useCase}
+}
+
+// Execute maneja DELETE /projects/:id/geometry con la cabecera If-Match; el proyecto conserva Lat/Lng
+func (c *DeleteProjectGeometryController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	expectedVersion, ok := requireIfMatch(ctx, id)
+	if !ok {
+		return
+	}
+
+	project, err := c.useCase.Execute(id, nil, expectedVersion, tokenUserId(ctx))
+	if err != nil {
+		respondQueryError(ctx, err, "Error al quitar la geometría del proyecto")
+		return
+	}
+
+	respondProject(ctx, http.StatusOK, project, "")
+}
@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteProjectMediaController struct {
+	useCase *application.DeleteProjectMediaUseCase
+}
+
+func NewDeleteProjectMediaController(useCase *application.DeleteProjectMediaUseCase) *DeleteProjectMediaController {
+	return &DeleteProjectMediaController{useCase: useCase}
+}
+
+// Execute maneja DELETE /projects/:id/media/:mediaId
+func (c *DeleteProjectMediaController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	mediaId, err := strconv.Atoi(ctx.Param("mediaId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID de imagen inválido", "success": false})
+		return
+	}
+
+	if err := c.useCase.Execute(id, mediaId, tokenUserId(ctx)); err != nil {
+		respondQueryError(ctx, err, "Error al eliminar la imagen")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true, "message": "Imagen eliminada de la galería"})
+}
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteProjectTemplateController struct {
+	useCase *application.DeleteProjectTemplateUseCase
+}
+
+func NewDeleteProjectTemplateController(useCase *application.DeleteProjectTemplateUseCase) *DeleteProjectTemplateController {
+	return &DeleteProjectTemplateController{useCase: useCase}
+}
+
+// Execute maneja DELETE /projects/templates/:templateId
+func (c *DeleteProjectTemplateController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("templateId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	if err := c.useCase.Execute(id, tokenUserId(ctx), requestIsAdmin(ctx)); err != nil {
+		respondQueryError(ctx, err, "Error al eliminar la plantilla")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true, "message": "Plantilla eliminada correctamente"})
+}
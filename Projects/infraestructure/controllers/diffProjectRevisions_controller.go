@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type DiffProjectRevisionsController struct {
+	useCase *application.DiffProjectRevisionsUseCase
+}
+
+func NewDiffProjectRevisionsController(useCase *application.DiffProjectRevisionsUseCase) *DiffProjectRevisionsController {
+	return &DiffProjectRevisionsController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/:id/history/diff?from=&to=
+func (c *DiffProjectRevisionsController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	from, err := queryInt(ctx, "from")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	to, err := queryInt(ctx, "to")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	diff, err := c.useCase.Execute(id, from, to)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al comparar revisiones")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    diff,
+	})
+}
@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type DownloadProjectDocumentController struct {
+	useCase *application.DownloadProjectDocumentUseCase
+}
+
+func NewDownloadProjectDocumentController(useCase *application.DownloadProjectDocumentUseCase) *DownloadProjectDocumentController {
+	return &DownloadProjectDocumentController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/:id/documents/:documentId y envía el archivo como descarga
+func (c *DownloadProjectDocumentController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	documentId, err := strconv.Atoi(ctx.Param("documentId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID de documento inválido", "success": false})
+		return
+	}
+
+	document, content, err := c.useCase.Execute(id, documentId)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al descargar el documento")
+		return
+	}
+	defer content.Close()
+
+	ctx.DataFromReader(http.StatusOK, document.Size, document.ContentType, content, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": document.FileName}),
+		"ETag":                `"` + document.Checksum + `"`,
+		"X-Checksum-SHA256":   document.Checksum,
+	})
+}
@@ -0,0 +1,29 @@
+package controllers
+
+import (
+	"io"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type ExportProjectsGPXController struct {
+	useCase *application.ExportProjectsGPXUseCase
+}
+
+func NewExportProjectsGPXController(useCase *application.ExportProjectsGPXUseCase) *ExportProjectsGPXController {
+	return &ExportProjectsGPXController{useCase: useCase}
+}
+
+// Execute maneja GET /projects.gpx con los mismos filtros que el listado de proyectos
+func (c *ExportProjectsGPXController) Execute(ctx *gin.Context) {
+	filter, err := parseProjectFilter(ctx)
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	streamDownload(ctx, "application/gpx+xml", "projects.gpx", func(w io.Writer) error {
+		return c.useCase.Execute(filter, w)
+	})
+}
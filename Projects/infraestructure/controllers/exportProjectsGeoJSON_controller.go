@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"io"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+type ExportProjectsGeoJSONController struct {
+	useCase *application.ExportProjectsGeoJSONUseCase
+}
+
+func NewExportProjectsGeoJSONController(useCase *application.ExportProjectsGeoJSONUseCase) *ExportProjectsGeoJSONController {
+	return &ExportProjectsGeoJSONController{useCase: useCase}
+}
+
+// Execute maneja GET /projects.geojson con los mismos filtros que el listado de proyectos y crs
+// opcional para las coordenadas
+func (c *ExportProjectsGeoJSONController) Execute(ctx *gin.Context) {
+	filter, err := parseProjectFilter(ctx)
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	crs, err := services.ParseCRS(ctx.Query("crs"))
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	streamDownload(ctx, "application/geo+json", "projects.geojson", func(w io.Writer) error {
+		return c.useCase.Execute(filter, crs, w)
+	})
+}
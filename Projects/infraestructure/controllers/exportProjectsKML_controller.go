@@ -0,0 +1,43 @@
+package controllers
+
+import (
+	"io"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type ExportProjectsKMLController struct {
+	useCase *application.ExportProjectsKMLUseCase
+}
+
+func NewExportProjectsKMLController(useCase *application.ExportProjectsKMLUseCase) *ExportProjectsKMLController {
+	return &ExportProjectsKMLController{useCase: useCase}
+}
+
+// Execute maneja GET /projects.kml con los mismos filtros que el listado de proyectos
+func (c *ExportProjectsKMLController) Execute(ctx *gin.Context) {
+	c.export(ctx, false)
+}
+
+// ExecuteKMZ maneja GET /projects.kmz, el mismo KML comprimido para Google Earth
+func (c *ExportProjectsKMLController) ExecuteKMZ(ctx *gin.Context) {
+	c.export(ctx, true)
+}
+
+func (c *ExportProjectsKMLController) export(ctx *gin.Context, compressed bool) {
+	filter, err := parseProjectFilter(ctx)
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	contentType, filename := "application/vnd.google-earth.kml+xml", "projects.kml"
+	if compressed {
+		contentType, filename = "application/vnd.google-earth.kmz", "projects.kmz"
+	}
+
+	streamDownload(ctx, contentType, filename, func(w io.Writer) error {
+		return c.useCase.Execute(filter, w, compressed)
+	})
+}
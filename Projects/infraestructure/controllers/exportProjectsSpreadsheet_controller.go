@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type ExportProjectsSpreadsheetController struct {
+	useCase *application.ExportProjectsSpreadsheetUseCase
+}
+
+func NewExportProjectsSpreadsheetController(useCase *application.ExportProjectsSpreadsheetUseCase) *ExportProjectsSpreadsheetController {
+	return &ExportProjectsSpreadsheetController{useCase: useCase}
+}
+
+// Execute maneja GET /projects.csv?columns=&lang=&delimiter=&tz= con los mismos filtros que el listado
+func (c *ExportProjectsSpreadsheetController) Execute(ctx *gin.Context) {
+	c.export(ctx, entities.SpreadsheetCSV)
+}
+
+// ExecuteXLSX maneja GET /projects.xlsx?columns=&lang=&tz=, la misma tabla como libro de Excel
+func (c *ExportProjectsSpreadsheetController) ExecuteXLSX(ctx *gin.Context) {
+	c.export(ctx, entities.SpreadsheetXLSX)
+}
+
+func (c *ExportProjectsSpreadsheetController) export(ctx *gin.Context, format string) {
+	filter, err := parseProjectFilter(ctx)
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	loc, err := entities.LoadTimezone(ctx.Query("tz"))
+	if err != nil {
+		respondQueryError(ctx, fmt.Errorf("%w: %s", entities.ErrInvalidFilter, err.Error()), "")
+		return
+	}
+	delimiter, err := parseCSVDelimiter(ctx.Query("delimiter"))
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	export := entities.SpreadsheetExport{
+		Format:    format,
+		Columns:   ctx.Query("columns"),
+		Language:  ctx.Query("lang"),
+		Location:  loc,
+		Delimiter: delimiter,
+	}
+	contentType, filename := "text/csv; charset=utf-8", "projects.csv"
+	if format == entities.SpreadsheetXLSX {
+		contentType, filename = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "projects.xlsx"
+	}
+
+	streamDownload(ctx, contentType, filename, func(w io.Writer) error {
+		return c.useCase.Execute(filter, w, export)
+	})
+}
+
+// parseCSVDelimiter acepta coma (por defecto), punto y coma, para Excel con configuración regional en
+// español, o tab
+func parseCSVDelimiter(value string) (rune, error) {
+	switch value {
+	case "", ",", "comma":
+		return ',', nil
+	case ";", "semicolon":
+		return ';', nil
+	case "tab", "\t":
+		return '\t', nil
+	}
+	return 0, fmt.Errorf("%w: delimiter debe ser comma, semicolon o tab", entities.ErrInvalidFilter)
+}
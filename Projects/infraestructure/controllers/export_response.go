@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// downloadWriteTimeout limita cuánto puede tardar el envío de una descarga. Las exportaciones leen
+// de la base de datos mientras escriben, así que sin límite un cliente lento retendría una conexión
+// del pool durante toda la descarga
+const downloadWriteTimeout = 2 * time.Minute
+
+// streamDownload envía una descarga generada al vuelo. Si la generación falla antes de escribir
+// se responde con un error JSON; si ya se enviaron datos solo se registra el error
+func streamDownload(ctx *gin.Context, contentType, filename string, write func(w io.Writer) error) {
+	// Al vencer el plazo las escrituras fallan y la generación se detiene, liberando la conexión.
+	// El plazo se quita al terminar para no afectar a otras peticiones de la misma conexión
+	rc := http.NewResponseController(ctx.Writer)
+	if err := rc.SetWriteDeadline(time.Now().Add(downloadWriteTimeout)); err != nil {
+		log.Printf("WARN: No se pudo fijar el plazo de escritura de %s: %v", filename, err)
+	}
+	defer rc.SetWriteDeadline(time.Time{})
+
+	ctx.Header("Content-Type", contentType)
+	ctx.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+
+	if err := write(ctx.Writer); err != nil {
+		if !ctx.Writer.Written() {
+			ctx.Header("Content-Disposition", "")
+			respondQueryError(ctx, err, "Error al exportar proyectos")
+			return
+		}
+		log.Printf("ERROR: Exportación de %s interrumpida: %v", filename, err)
+	}
+}
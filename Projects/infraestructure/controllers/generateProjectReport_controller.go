@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type GenerateProjectReportController struct {
+	useCase *application.GenerateProjectReportUseCase
+}
+
+func NewGenerateProjectReportController(useCase *application.GenerateProjectReportUseCase) *GenerateProjectReportController {
+	return &GenerateProjectReportController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/:id/report.pdf?lang=&tz=
+func (c *GenerateProjectReportController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	loc, err := entities.LoadTimezone(ctx.Query("tz"))
+	if err != nil {
+		respondQueryError(ctx, fmt.Errorf("%w: %s", entities.ErrInvalidFilter, err.Error()), "")
+		return
+	}
+
+	filename := fmt.Sprintf("proyecto-%d.pdf", id)
+	streamDownload(ctx, "application/pdf", filename, func(w io.Writer) error {
+		return c.useCase.Execute(id, w, ctx.Query("lang"), loc)
+	})
+}
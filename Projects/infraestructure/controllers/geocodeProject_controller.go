@@ -0,0 +1,34 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GeocodeProjectController struct {
+	useCase *application.GeocodeProjectUseCase
+}
+
+func NewGeocodeProjectController(useCase *application.GeocodeProjectUseCase) *GeocodeProjectController {
+	return &GeocodeProjectController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/:id/geocode; la dirección no cambia la versión del proyecto
+func (c *GeocodeProjectController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	project, err := c.useCase.Execute(id)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al geocodificar el proyecto")
+		return
+	}
+
+	respondProject(ctx, http.StatusOK, project, "")
+}
@@ -1,9 +1,11 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,10 +19,44 @@ func NewGetAllProjectsController(useCase *application.GetAllProjectsUseCase) *Ge
 }
 
 func (c *GetAllProjectsController) Execute(ctx *gin.Context) {
-	proyects, err := c.useCase.Execute()
+	filter, err := parseProjectFilter(ctx)
 	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+
+	crs, err := outputCRS(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+
+	page, err := c.useCase.Execute(filter)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidFilter) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Error al obtener al obtener la lista de proyectos: " + err.Error()})
 		return
 	}
-	ctx.JSON(http.StatusOK, proyects)
+
+	var data interface{} = page.Projects
+	if crs != 0 {
+		if data, err = projectsInCRS(page.Projects, crs); err != nil {
+			respondQueryError(ctx, err, "")
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    data,
+		"pagination": gin.H{
+			"total":       page.Total,
+			"limit":       page.Limit,
+			"next_cursor": page.NextCursor,
+			"next":        nextPageLink(ctx, page.NextCursor),
+		},
+	})
 }
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
 	"github.com/gin-gonic/gin"
 )
 
@@ -25,10 +26,34 @@ func (c *GetProjectByIdController) Execute(ctx *gin.Context){
 		return
 	}
 
+	// Con crs la respuesta incluye además la ubicación y la geometría en ese sistema
+	crs, err := outputCRS(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H {"error": err.Error()})
+		return
+	}
+
 	project, err := c.useCase.Execute(id)
 	if  err != nil {
 		ctx.JSON(http.StatusNotFound, gin.H {"error": "Proyecto inexistente"})
 		return
 	}
+
+	// El ETag se envía en If-Match al modificar el proyecto
+	etag := projectETag(*project)
+	ctx.Header("ETag", etag)
+	if ctx.GetHeader("If-None-Match") == etag {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+	if crs != 0 {
+		converted, err := services.ProjectToCRS(*project, crs)
+		if err != nil {
+			respondQueryError(ctx, err, "")
+			return
+		}
+		ctx.JSON(http.StatusOK, converted)
+		return
+	}
 	ctx.JSON(http.StatusOK, project)
 }	
\ No newline at end of file
@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetCategoriesController struct {
+	useCase *application.GetCategoriesUseCase
+}
+
+func NewGetCategoriesController(useCase *application.GetCategoriesUseCase) *GetCategoriesController {
+	return &GetCategoriesController{useCase: useCase}
+}
+
+// Execute maneja GET /categories?orgId=
+func (c *GetCategoriesController) Execute(ctx *gin.Context) {
+	orgId, err := queryInt(ctx, "orgId")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	categories, err := c.useCase.Execute(orgId)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al obtener las categorías")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    categories,
+	})
+}
@@ -0,0 +1,30 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetImportJobController struct {
+	useCase *application.GetImportJobUseCase
+}
+
+func NewGetImportJobController(useCase *application.GetImportJobUseCase) *GetImportJobController {
+	return &GetImportJobController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/import/jobs/:jobId
+func (c *GetImportJobController) Execute(ctx *gin.Context) {
+	job, err := c.useCase.Execute(ctx.Param("jobId"))
+	if err != nil {
+		respondQueryError(ctx, err, "Error al consultar la importación")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    job,
+	})
+}
@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetMeasurementsController struct {
+	useCase *application.GetMeasurementsUseCase
+}
+
+func NewGetMeasurementsController(useCase *application.GetMeasurementsUseCase) *GetMeasurementsController {
+	return &GetMeasurementsController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/:id/measurements?after=&limit=
+func (c *GetMeasurementsController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	after, err := queryInt(ctx, "after")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	limit, err := queryInt(ctx, "limit")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	page, err := c.useCase.Execute(id, after, limit)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al obtener los puntos")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    page.Measurements,
+		"pagination": gin.H{
+			"limit":      page.Limit,
+			"next_after": page.NextAfter,
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetNearestProjectsController struct {
+	useCase *application.GetNearestProjectsUseCase
+}
+
+func NewGetNearestProjectsController(useCase *application.GetNearestProjectsUseCase) *GetNearestProjectsController {
+	return &GetNearestProjectsController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/nearest?lat=&lng=&k=
+func (c *GetNearestProjectsController) Execute(ctx *gin.Context) {
+	lat, err := queryFloat(ctx, "lat")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	lng, err := queryFloat(ctx, "lng")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	k, err := queryInt(ctx, "k")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	projects, err := c.useCase.Execute(lat, lng, k)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al buscar los proyectos más cercanos")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    projects,
+	})
+}
@@ -0,0 +1,43 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+type GetProjectClustersController struct {
+	useCase *application.GetProjectClustersUseCase
+}
+
+func NewGetProjectClustersController(useCase *application.GetProjectClustersUseCase) *GetProjectClustersController {
+	return &GetProjectClustersController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/clusters?bbox=minLng,minLat,maxLng,maxLat&zoom= con los mismos filtros
+// que GET /projects
+func (c *GetProjectClustersController) Execute(ctx *gin.Context) {
+	filter, err := parseProjectFilter(ctx)
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	zoom, err := services.ParseMapZoom(ctx.Query("zoom"))
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	projectMap, err := c.useCase.Execute(filter, zoom)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al agrupar los proyectos del mapa")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    projectMap,
+	})
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetProjectDocumentsController struct {
+	useCase *application.GetProjectDocumentsUseCase
+}
+
+func NewGetProjectDocumentsController(useCase *application.GetProjectDocumentsUseCase) *GetProjectDocumentsController {
+	return &GetProjectDocumentsController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/:id/documents
+func (c *GetProjectDocumentsController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	documents, err := c.useCase.Execute(id)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al obtener los documentos")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    documents,
+	})
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetProjectHistoryController struct {
+	useCase *application.GetProjectHistoryUseCase
+}
+
+func NewGetProjectHistoryController(useCase *application.GetProjectHistoryUseCase) *GetProjectHistoryController {
+	return &GetProjectHistoryController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/:id/history?limit=&before=. before es el número de revisión desde el que
+// continúa la página siguiente
+func (c *GetProjectHistoryController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	limit, err := queryInt(ctx, "limit")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	before, err := queryInt(ctx, "before")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	page, err := c.useCase.Execute(id, before, limit)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al obtener el historial del proyecto")
+		return
+	}
+
+	pagination := gin.H{"limit": page.Limit}
+	if page.NextBefore > 0 {
+		next := ctx.Request.URL.Query()
+		next.Set("before", strconv.Itoa(page.NextBefore))
+		pagination["next_before"] = page.NextBefore
+		pagination["next"] = ctx.Request.URL.Path + "?" + next.Encode()
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"data":       page.Revisions,
+		"pagination": pagination,
+	})
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetProjectMediaController struct {
+	useCase *application.GetProjectMediaUseCase
+}
+
+func NewGetProjectMediaController(useCase *application.GetProjectMediaUseCase) *GetProjectMediaController {
+	return &GetProjectMediaController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/:id/media
+func (c *GetProjectMediaController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	media, err := c.useCase.Execute(id)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al obtener la galería")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    media,
+	})
+}
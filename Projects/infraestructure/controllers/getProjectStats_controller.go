@@ -1,11 +1,16 @@
 package controllers
 
 import (
+    "fmt"
     "net/http"
     "strconv"
+    "strings"
+    "time"
 
     "github.com/gin-gonic/gin"
     "github.com/JosephAntony37900/Geova-back-1/Projects/application"
+    "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+    "github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
 )
 
 type GetProjectStatsController struct {
@@ -16,51 +21,46 @@ func NewGetProjectStatsController(useCase *application.GetProjectStatsUseCase) *
     return &GetProjectStatsController{useCase: useCase}
 }
 
+// Execute maneja GET /projects/stats?userId=&granularity=&from=&to=&last=&month=&year=&days=&tz=
 func (c *GetProjectStatsController) Execute(ctx *gin.Context) {
-    // Obtener userId del query parameter
-    userIdStr := ctx.Query("userId")
-    if userIdStr == "" {
-        ctx.JSON(http.StatusBadRequest, gin.H{
-            "error":   "El parámetro userId es obligatorio",
-            "success": false,
-        })
-        return
+    // Sin userId las estadísticas son de toda la organización
+    userId := 0
+    if userIdStr := ctx.Query("userId"); userIdStr != "" {
+        parsed, err := strconv.Atoi(userIdStr)
+        if err != nil || parsed <= 0 {
+            ctx.JSON(http.StatusBadRequest, gin.H{
+                "error":   "El userId debe ser un número mayor a 0",
+                "success": false,
+            })
+            return
+        }
+        userId = parsed
     }
 
-    userId, err := strconv.Atoi(userIdStr)
+    // Zona horaria IANA en la que se agrupan los periodos (opcional, por defecto UTC)
+    loc, err := entities.LoadTimezone(ctx.Query("tz"))
     if err != nil {
-        ctx.JSON(http.StatusBadRequest, gin.H{
-            "error":   "El userId debe ser un número válido",
-            "success": false,
-        })
+        respondQueryError(ctx, fmt.Errorf("%w: %s", entities.ErrInvalidFilter, err.Error()), "")
         return
     }
 
-    if userId <= 0 {
-        ctx.JSON(http.StatusBadRequest, gin.H{
-            "error":   "El userId debe ser mayor a 0",
-            "success": false,
-        })
+    dateRange, err := parseDateRange(ctx)
+    if err != nil {
+        respondQueryError(ctx, err, "")
         return
     }
-
-    // Obtener días (opcional, por defecto 7)
-    days := 7
-    daysStr := ctx.Query("days")
-    if daysStr != "" {
-        parsedDays, err := strconv.Atoi(daysStr)
-        if err == nil && parsedDays > 0 {
-            days = parsedDays
+    // days=N se mantiene como equivalente de last=Nd
+    if days := strings.TrimSpace(ctx.Query("days")); days != "" && dateRange.From.IsZero() && dateRange.To.IsZero() {
+        dateRange, err = services.ResolveDateRange(entities.DateRangeParams{Last: days + "d"}, time.Now(), loc)
+        if err != nil {
+            respondQueryError(ctx, err, "")
+            return
         }
     }
 
-    // Ejecutar use case
-    stats, err := c.useCase.Execute(userId, days)
+    stats, err := c.useCase.Execute(userId, ctx.Query("granularity"), dateRange, loc)
     if err != nil {
-        ctx.JSON(http.StatusInternalServerError, gin.H{
-            "error":   "Error al obtener estadísticas: " + err.Error(),
-            "success": false,
-        })
+        respondQueryError(ctx, err, "Error al obtener estadísticas")
         return
     }
 
@@ -68,4 +68,4 @@ func (c *GetProjectStatsController) Execute(ctx *gin.Context) {
         "success": true,
         "data":    stats,
     })
-}
\ No newline at end of file
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetProjectStatusHistoryController struct {
+	useCase *application.GetProjectStatusHistoryUseCase
+}
+
+func NewGetProjectStatusHistoryController(useCase *application.GetProjectStatusHistoryUseCase) *GetProjectStatusHistoryController {
+	return &GetProjectStatusHistoryController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/:id/status/history
+func (c *GetProjectStatusHistoryController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	transitions, err := c.useCase.Execute(id)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al obtener el historial de estados del proyecto")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    transitions,
+	})
+}
@@ -0,0 +1,24 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetProjectStatusWorkflowController struct {
+	useCase *application.GetProjectStatusWorkflowUseCase
+}
+
+func NewGetProjectStatusWorkflowController(useCase *application.GetProjectStatusWorkflowUseCase) *GetProjectStatusWorkflowController {
+	return &GetProjectStatusWorkflowController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/statuses
+func (c *GetProjectStatusWorkflowController) Execute(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    c.useCase.Execute(),
+	})
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetProjectTagsController struct {
+	useCase *application.GetProjectTagsUseCase
+}
+
+func NewGetProjectTagsController(useCase *application.GetProjectTagsUseCase) *GetProjectTagsController {
+	return &GetProjectTagsController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/:id/tags
+func (c *GetProjectTagsController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	tags, err := c.useCase.Execute(id)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al obtener las etiquetas")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    tags,
+	})
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetProjectTemplateController struct {
+	useCase *application.GetProjectTemplateUseCase
+}
+
+func NewGetProjectTemplateController(useCase *application.GetProjectTemplateUseCase) *GetProjectTemplateController {
+	return &GetProjectTemplateController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/templates/:templateId
+func (c *GetProjectTemplateController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("templateId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	template, err := c.useCase.Execute(id)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al obtener la plantilla")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    template,
+	})
+}
@@ -0,0 +1,30 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetProjectTemplatesController struct {
+	useCase *application.GetProjectTemplatesUseCase
+}
+
+func NewGetProjectTemplatesController(useCase *application.GetProjectTemplatesUseCase) *GetProjectTemplatesController {
+	return &GetProjectTemplatesController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/templates
+func (c *GetProjectTemplatesController) Execute(ctx *gin.Context) {
+	templates, err := c.useCase.Execute()
+	if err != nil {
+		respondQueryError(ctx, err, "Error al obtener las plantillas")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    templates,
+	})
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -24,9 +25,19 @@ func (c *GetProjectByDateController) Execute(ctx *gin.Context) {
 	
 	fmt.Printf("DEBUG GetProjectByDate - Fecha recibida: '%s'\n", fecha)
 
-	projects, err := c.useCase.Execute(fecha)
+	loc, err := entities.LoadTimezone(ctx.Query("tz"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	projects, err := c.useCase.Execute(fecha, loc)
 	if err != nil {
 		fmt.Printf("DEBUG GetProjectByDate - Error: %v\n", err)
+		if errors.Is(err, entities.ErrInvalidFilter) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
 	}
@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type GetProjectsNearController struct {
+	useCase *application.GetProjectsNearUseCase
+}
+
+func NewGetProjectsNearController(useCase *application.GetProjectsNearUseCase) *GetProjectsNearController {
+	return &GetProjectsNearController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/near?lat=&lng=&radius=&limit= (radio en metros)
+func (c *GetProjectsNearController) Execute(ctx *gin.Context) {
+	lat, err := queryFloat(ctx, "lat")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	lng, err := queryFloat(ctx, "lng")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	radius, err := queryFloat(ctx, "radius")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	limit, err := queryInt(ctx, "limit")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	projects, err := c.useCase.Execute(lat, lng, radius, limit)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al buscar proyectos cercanos")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    projects,
+	})
+}
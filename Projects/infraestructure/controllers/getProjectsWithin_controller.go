@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type GetProjectsWithinController struct {
+	useCase *application.GetProjectsWithinUseCase
+}
+
+func NewGetProjectsWithinController(useCase *application.GetProjectsWithinUseCase) *GetProjectsWithinController {
+	return &GetProjectsWithinController{useCase: useCase}
+}
+
+// Execute maneja GET /projects/within?bbox=minLng,minLat,maxLng,maxLat&limit=
+func (c *GetProjectsWithinController) Execute(ctx *gin.Context) {
+	bboxStr := ctx.Query("bbox")
+	if bboxStr == "" {
+		respondQueryError(ctx, fmt.Errorf("%w: el parámetro bbox es obligatorio", entities.ErrInvalidFilter), "")
+		return
+	}
+	bbox, err := parseBoundingBox(bboxStr)
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+	limit, err := queryInt(ctx, "limit")
+	if err != nil {
+		respondQueryError(ctx, err, "")
+		return
+	}
+
+	projects, err := c.useCase.Execute(*bbox, limit)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al buscar proyectos en el área")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    projects,
+	})
+}
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+// maxCSVImportBytes limita el tamaño del CSV recibido
+const maxCSVImportBytes = 20 << 20
+
+type ImportProjectsCSVController struct {
+	useCase *application.ImportProjectsCSVUseCase
+}
+
+func NewImportProjectsCSVController(useCase *application.ImportProjectsCSVUseCase) *ImportProjectsCSVController {
+	return &ImportProjectsCSVController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/import/csv?dryRun=&async=&tz=&delimiter= con el archivo en el campo file
+// y, opcionalmente, el mapeo de columnas como objeto JSON en el campo mapping. Los proyectos pertenecen
+// al usuario del token
+func (c *ImportProjectsCSVController) Execute(ctx *gin.Context) {
+	opts := entities.CSVImportOptions{
+		DryRun:  ctx.Query("dryRun") == "true",
+		Async:   ctx.Query("async") == "true",
+		UserId:  tokenUserId(ctx),
+		IsAdmin: requestIsAdmin(ctx),
+	}
+
+	loc, err := entities.LoadTimezone(ctx.Query("tz"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+	opts.Location = loc
+
+	// Sin delimiter se detecta el separador en el encabezado
+	if delimiterStr := ctx.Query("delimiter"); delimiterStr != "" {
+		delimiter, err := parseCSVDelimiter(delimiterStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+			return
+		}
+		opts.Delimiter = delimiter
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxCSVImportBytes)
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Se requiere el archivo CSV en el campo file", "success": false})
+		return
+	}
+	if mapping := ctx.PostForm("mapping"); mapping != "" {
+		if err := json.Unmarshal([]byte(mapping), &opts.Mapping); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "El mapping debe ser un objeto JSON {campo: columna}", "success": false})
+			return
+		}
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "No se pudo leer el archivo", "success": false})
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "No se pudo leer el archivo", "success": false})
+		return
+	}
+
+	report, job, err := c.useCase.Execute(data, opts)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al importar proyectos")
+		return
+	}
+
+	if job != nil {
+		ctx.Header("Location", "/projects/import/jobs/"+job.Id)
+		ctx.JSON(http.StatusAccepted, gin.H{
+			"success": true,
+			"data":    job,
+		})
+		return
+	}
+
+	status := http.StatusCreated
+	switch {
+	case report.DryRun:
+		status = http.StatusOK
+	case report.Created == 0:
+		status = http.StatusUnprocessableEntity
+	case report.Failed > 0:
+		status = http.StatusMultiStatus
+	}
+
+	ctx.JSON(status, gin.H{
+		"success": report.Failed == 0,
+		"data":    report,
+	})
+}
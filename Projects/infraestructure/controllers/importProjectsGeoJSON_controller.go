@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportBodyBytes limita el tamaño del GeoJSON recibido
+const maxImportBodyBytes = 10 << 20
+
+type ImportProjectsGeoJSONController struct {
+	useCase *application.ImportProjectsGeoJSONUseCase
+}
+
+func NewImportProjectsGeoJSONController(useCase *application.ImportProjectsGeoJSONUseCase) *ImportProjectsGeoJSONController {
+	return &ImportProjectsGeoJSONController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/import?tz=&crs= con un FeatureCollection en el cuerpo. Los proyectos
+// pertenecen al usuario del token; tz se usa para las fechas sin zona horaria y crs para coordenadas
+// que no están en WGS84
+func (c *ImportProjectsGeoJSONController) Execute(ctx *gin.Context) {
+	loc, err := entities.LoadTimezone(ctx.Query("tz"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxImportBodyBytes)
+	var collection entities.GeoJSONFeatureCollection
+	if err := ctx.ShouldBindJSON(&collection); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "GeoJSON inválido: " + err.Error(), "success": false})
+		return
+	}
+
+	// crs tiene prioridad sobre el miembro crs del FeatureCollection
+	if crsStr := ctx.Query("crs"); crsStr != "" {
+		crs, err := services.ParseCRS(crsStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+			return
+		}
+		collection.CRS = services.NamedGeoJSONCRS(crs)
+	}
+
+	report, err := c.useCase.Execute(collection, tokenUserId(ctx), requestIsAdmin(ctx), loc)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidInput) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Error al importar proyectos: " + err.Error(), "success": false})
+		return
+	}
+
+	status := http.StatusCreated
+	switch {
+	case report.Created == 0:
+		status = http.StatusUnprocessableEntity
+	case report.Failed > 0:
+		status = http.StatusMultiStatus
+	}
+
+	ctx.JSON(status, gin.H{
+		"success": report.Failed == 0,
+		"data":    report,
+	})
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+// maxPatchBodyBytes limita el tamaño del parche recibido
+const maxPatchBodyBytes = 1 << 20
+
+type PatchProjectController struct {
+	useCase *application.PatchProjectUseCase
+}
+
+func NewPatchProjectController(useCase *application.PatchProjectUseCase) *PatchProjectController {
+	return &PatchProjectController{useCase: useCase}
+}
+
+// Execute maneja PATCH /projects/:id?tz= con un JSON Merge Patch y la cabecera If-Match
+func (c *PatchProjectController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	if contentType := ctx.ContentType(); contentType != "application/merge-patch+json" && contentType != "application/json" {
+		ctx.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type debe ser application/merge-patch+json", "success": false})
+		return
+	}
+	expectedVersion, ok := requireIfMatch(ctx, id)
+	if !ok {
+		return
+	}
+	loc, err := entities.LoadTimezone(ctx.Query("tz"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+
+	patch, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxPatchBodyBytes))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "No se pudo leer el parche: " + err.Error(), "success": false})
+		return
+	}
+
+	project, err := c.useCase.Execute(id, patch, expectedVersion, tokenUserId(ctx), requestIsAdmin(ctx), loc)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al actualizar proyecto")
+		return
+	}
+
+	respondProject(ctx, http.StatusOK, project, "")
+}
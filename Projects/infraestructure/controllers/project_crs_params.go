@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+// inputCRS lee el sistema de referencia de las coordenadas recibidas, del campo del formulario o del
+// parámetro crs; sin indicarlo se asume WGS84
+func inputCRS(ctx *gin.Context) (int, error) {
+	value, ok := ctx.GetPostForm("crs")
+	if !ok {
+		value = ctx.Query("crs")
+	}
+	return services.ParseCRS(value)
+}
+
+// readProjectedLocation lee x/y (este y norte, o lng y lat en WGS84) en el CRS indicado y los
+// reproyecta a Lat/Lng. Si no se envían se conservan lat/lng del formulario
+func readProjectedLocation(ctx *gin.Context, crs int, project *entities.Project) error {
+	xStr, yStr := ctx.PostForm("x"), ctx.PostForm("y")
+	if xStr == "" && yStr == "" {
+		return nil
+	}
+	x, errX := strconv.ParseFloat(xStr, 64)
+	y, errY := strconv.ParseFloat(yStr, 64)
+	if errX != nil || errY != nil {
+		return fmt.Errorf("%w: x e y deben ser números", entities.ErrInvalidInput)
+	}
+	lat, lng, err := services.ToWGS84(crs, x, y)
+	if err != nil {
+		return err
+	}
+	project.Lat, project.Lng = lat, lng
+	return nil
+}
+
+// outputCRS lee el parámetro crs con el que se piden las respuestas; 0 indica WGS84 sin conversión
+func outputCRS(ctx *gin.Context) (int, error) {
+	crs, err := services.ParseCRS(ctx.Query("crs"))
+	if err != nil || crs == entities.CRSWGS84 {
+		return 0, err
+	}
+	return crs, nil
+}
+
+// projectsInCRS agrega a cada proyecto su ubicación y su geometría en el CRS pedido
+func projectsInCRS(projects []entities.Project, crs int) ([]entities.ProjectInCRS, error) {
+	result := make([]entities.ProjectInCRS, 0, len(projects))
+	for _, project := range projects {
+		converted, err := services.ProjectToCRS(project, crs)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+	return result, nil
+}
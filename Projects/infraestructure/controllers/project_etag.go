@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+// projectETag es la etiqueta de entidad de un proyecto; cambia con cada versión
+func projectETag(project entities.Project) string {
+	return fmt.Sprintf(`"%d-%d"`, project.Id, project.Version)
+}
+
+// requireIfMatch lee la versión esperada de la cabecera If-Match; * equivale a 0 (cualquier versión).
+// Si la cabecera falta responde 428 y si no corresponde al proyecto responde 412
+func requireIfMatch(ctx *gin.Context, id int) (int, bool) {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if header == "" {
+		ctx.JSON(http.StatusPreconditionRequired, gin.H{"error": "Se requiere la cabecera If-Match con el ETag del proyecto", "success": false})
+		return 0, false
+	}
+	if header == "*" {
+		return 0, true
+	}
+	if strings.Contains(header, ",") {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "If-Match debe contener un solo ETag", "success": false})
+		return 0, false
+	}
+
+	// If-Match usa comparación fuerte, por lo que un ETag débil (W/) nunca coincide
+	var etagId, version int
+	if _, err := fmt.Sscanf(header, `"%d-%d"`, &etagId, &version); err != nil || etagId != id || version <= 0 {
+		ctx.JSON(http.StatusPreconditionFailed, gin.H{"error": "El ETag de If-Match no corresponde al proyecto", "success": false})
+		return 0, false
+	}
+	return version, true
+}
+
+// respondProject responde el proyecto con su ETag
+func respondProject(ctx *gin.Context, status int, project *entities.Project, message string) {
+	ctx.Header("ETag", projectETag(*project))
+	body := gin.H{"success": true, "data": project}
+	if message != "" {
+		body["message"] = message
+	}
+	ctx.JSON(status, body)
+}
@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+// parseProjectFilter lee los filtros comunes de listado desde el query string:
+// categoria, userId, from/to, last, month, year, tz, nombre, bbox, tags, tagMatch, status, municipality,
+// state, country, sort, cursor y limit
+func parseProjectFilter(ctx *gin.Context) (entities.ProjectFilter, error) {
+	filter := entities.ProjectFilter{
+		Categoria:    strings.TrimSpace(ctx.Query("categoria")),
+		Nombre:       strings.TrimSpace(ctx.Query("nombre")),
+		TagMatch:     strings.ToLower(strings.TrimSpace(ctx.Query("tagMatch"))),
+		Municipality: strings.TrimSpace(ctx.Query("municipality")),
+		State:        strings.TrimSpace(ctx.Query("state")),
+		Country:      strings.TrimSpace(ctx.Query("country")),
+		Cursor:       ctx.Query("cursor"),
+	}
+	if tags := ctx.Query("tags"); tags != "" {
+		filter.Tags = strings.Split(tags, ",")
+	}
+	if statuses := ctx.Query("status"); statuses != "" {
+		filter.Statuses = strings.Split(statuses, ",")
+	}
+
+	dateRange, err := parseDateRange(ctx)
+	if err != nil {
+		return filter, err
+	}
+	filter.FechaDesde, filter.FechaHasta = dateRange.From, dateRange.To
+
+	if userIdStr := ctx.Query("userId"); userIdStr != "" {
+		userId, err := strconv.Atoi(userIdStr)
+		if err != nil || userId <= 0 {
+			return filter, fmt.Errorf("%w: userId debe ser un número mayor a 0", entities.ErrInvalidFilter)
+		}
+		filter.UserId = userId
+	}
+
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return filter, fmt.Errorf("%w: limit debe ser un número mayor a 0", entities.ErrInvalidFilter)
+		}
+		filter.Limit = limit
+	}
+
+	if sort := strings.TrimSpace(ctx.Query("sort")); sort != "" {
+		filter.SortDesc = strings.HasPrefix(sort, "-")
+		filter.SortField = strings.TrimPrefix(sort, "-")
+	}
+
+	if bboxStr := ctx.Query("bbox"); bboxStr != "" {
+		bbox, err := parseBoundingBox(bboxStr)
+		if err != nil {
+			return filter, err
+		}
+		filter.BBox = bbox
+	}
+
+	return filter, nil
+}
+
+// parseDateRange lee un rango de fechas (from/to, last, month o year) en la zona horaria tz
+func parseDateRange(ctx *gin.Context) (entities.DateRange, error) {
+	loc, err := entities.LoadTimezone(ctx.Query("tz"))
+	if err != nil {
+		return entities.DateRange{}, fmt.Errorf("%w: %s", entities.ErrInvalidFilter, err.Error())
+	}
+	params := entities.DateRangeParams{
+		From:  strings.TrimSpace(ctx.Query("from")),
+		To:    strings.TrimSpace(ctx.Query("to")),
+		Last:  strings.TrimSpace(ctx.Query("last")),
+		Month: strings.TrimSpace(ctx.Query("month")),
+		Year:  strings.TrimSpace(ctx.Query("year")),
+	}
+	return services.ResolveDateRange(params, time.Now(), loc)
+}
+
+// parseBoundingBox interpreta un bbox con el orden de GeoJSON: minLng,minLat,maxLng,maxLat
+func parseBoundingBox(value string) (*entities.BoundingBox, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("%w: bbox debe tener el formato minLng,minLat,maxLng,maxLat", entities.ErrInvalidFilter)
+	}
+	coords := make([]float64, 4)
+	for i, part := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		// ParseFloat acepta NaN e Inf, que pasarían cualquier comparación de rango
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("%w: bbox contiene un valor no numérico", entities.ErrInvalidFilter)
+		}
+		coords[i] = v
+	}
+	return &entities.BoundingBox{
+		MinLng: coords[0],
+		MinLat: coords[1],
+		MaxLng: coords[2],
+		MaxLat: coords[3],
+	}, nil
+}
+
+// nextPageLink construye el enlace a la página siguiente conservando los filtros de la petición
+func nextPageLink(ctx *gin.Context, cursor string) string {
+	if cursor == "" {
+		return ""
+	}
+	query := ctx.Request.URL.Query()
+	query.Set("cursor", cursor)
+	next := url.URL{Path: ctx.Request.URL.Path, RawQuery: query.Encode()}
+	return next.String()
+}
+
+// queryFloat lee un parámetro numérico obligatorio del query string
+func queryFloat(ctx *gin.Context, name string) (float64, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return 0, fmt.Errorf("%w: el parámetro %s es obligatorio", entities.ErrInvalidFilter, name)
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("%w: el parámetro %s debe ser numérico", entities.ErrInvalidFilter, name)
+	}
+	return f, nil
+}
+
+// queryInt lee un parámetro entero opcional del query string
+func queryInt(ctx *gin.Context, name string) (int, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: el parámetro %s debe ser un número entero", entities.ErrInvalidFilter, name)
+	}
+	return i, nil
+}
+
+// respondQueryError responde 400 si los parámetros son inválidos, 404 si el recurso no existe,
+// 409 si choca con el estado actual, 412 si cambió desde la versión indicada en If-Match,
+// 503 si un servicio externo no está disponible o 500 en cualquier otro caso
+func respondQueryError(ctx *gin.Context, err error, message string) {
+	if errors.Is(err, entities.ErrInvalidFilter) || errors.Is(err, entities.ErrInvalidInput) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+	if errors.Is(err, entities.ErrNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+	if errors.Is(err, entities.ErrForbidden) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+	if errors.Is(err, entities.ErrConflict) {
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+	if errors.Is(err, entities.ErrVersionConflict) {
+		ctx.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+	if errors.Is(err, entities.ErrUnavailable) {
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": message + ": " + err.Error(), "success": false})
+}
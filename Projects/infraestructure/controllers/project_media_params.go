@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+// saveMediaUploads guarda en tmp las imágenes del campo media (se puede repetir) junto con sus datos
+// opcionales, alineados por posición: mediaCaption, mediaCapturedAt, mediaLat y mediaLng.
+// mediaCapturedAt se interpreta en la zona horaria loc. Sin archivos devuelve una lista vacía
+func saveMediaUploads(ctx *gin.Context, loc *time.Location) ([]entities.MediaUpload, error) {
+	form, err := ctx.MultipartForm()
+	if err != nil || len(form.File["media"]) == 0 {
+		return nil, nil
+	}
+	files := form.File["media"]
+	if len(files) > services.MaxMediaPerUpload {
+		return nil, fmt.Errorf("%w: se permiten como máximo %d imágenes por petición", entities.ErrInvalidInput, services.MaxMediaPerUpload)
+	}
+
+	captions := ctx.PostFormArray("mediaCaption")
+	capturedAts := ctx.PostFormArray("mediaCapturedAt")
+	lats := ctx.PostFormArray("mediaLat")
+	lngs := ctx.PostFormArray("mediaLng")
+
+	uploads := make([]entities.MediaUpload, 0, len(files))
+	for i, file := range files {
+		upload := entities.MediaUpload{Caption: strings.TrimSpace(valueAt(captions, i))}
+
+		if value := strings.TrimSpace(valueAt(capturedAts, i)); value != "" {
+			capturedAt, err := entities.ParseProjectDate(value, loc)
+			if err != nil {
+				removeMediaUploads(uploads)
+				return nil, fmt.Errorf("%w: mediaCapturedAt de la imagen %d: %s", entities.ErrInvalidInput, i+1, err.Error())
+			}
+			upload.CapturedAt = &capturedAt
+		}
+		if upload.Lat, err = optionalFloat(valueAt(lats, i)); err != nil {
+			removeMediaUploads(uploads)
+			return nil, fmt.Errorf("%w: mediaLat de la imagen %d debe ser numérica", entities.ErrInvalidInput, i+1)
+		}
+		if upload.Lng, err = optionalFloat(valueAt(lngs, i)); err != nil {
+			removeMediaUploads(uploads)
+			return nil, fmt.Errorf("%w: mediaLng de la imagen %d debe ser numérica", entities.ErrInvalidInput, i+1)
+		}
+
+		if upload.LocalPath, err = saveTempUpload(file); err != nil {
+			removeMediaUploads(uploads)
+			return nil, fmt.Errorf("error al guardar la imagen temporal %d: %w", i+1, err)
+		}
+		uploads = append(uploads, upload)
+	}
+	return uploads, nil
+}
+
+// saveTempUpload copia el archivo recibido a tmp con un nombre único, para que dos peticiones
+// simultáneas no se sobrescriban las imágenes, y devuelve su ruta
+func saveTempUpload(file *multipart.FileHeader) (string, error) {
+	src, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	if err := os.MkdirAll("tmp", 0o750); err != nil {
+		return "", err
+	}
+	dst, err := os.CreateTemp("tmp", "tmp_*"+filepath.Ext(file.Filename))
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		os.Remove(dst.Name())
+		return "", err
+	}
+	if err := dst.Close(); err != nil {
+		os.Remove(dst.Name())
+		return "", err
+	}
+	return dst.Name(), nil
+}
+
+// removeMediaUploads elimina los archivos temporales de la galería
+func removeMediaUploads(uploads []entities.MediaUpload) {
+	for _, upload := range uploads {
+		os.Remove(upload.LocalPath)
+	}
+}
+
+func valueAt(values []string, i int) string {
+	if i < len(values) {
+		return values[i]
+	}
+	return ""
+}
+
+func optionalFloat(value string) (*float64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
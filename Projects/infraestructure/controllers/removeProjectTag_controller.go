@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type RemoveProjectTagController struct {
+	useCase *application.RemoveProjectTagUseCase
+}
+
+func NewRemoveProjectTagController(useCase *application.RemoveProjectTagUseCase) *RemoveProjectTagController {
+	return &RemoveProjectTagController{useCase: useCase}
+}
+
+// Execute maneja DELETE /projects/:id/tags/:tag
+func (c *RemoveProjectTagController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	if err := c.useCase.Execute(id, ctx.Param("tag")); err != nil {
+		respondQueryError(ctx, err, "Error al quitar la etiqueta")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true, "message": "Etiqueta eliminada del proyecto"})
+}
@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type ReorderProjectMediaController struct {
+	useCase *application.ReorderProjectMediaUseCase
+}
+
+func NewReorderProjectMediaController(useCase *application.ReorderProjectMediaUseCase) *ReorderProjectMediaController {
+	return &ReorderProjectMediaController{useCase: useCase}
+}
+
+// Execute maneja PUT /projects/:id/media/order con {"ids": [5, 3, 4]}
+func (c *ReorderProjectMediaController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	var body struct {
+		Ids []int `json:"ids"`
+	}
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+		return
+	}
+
+	media, err := c.useCase.Execute(id, body.Ids)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al ordenar la galería")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    media,
+	})
+}
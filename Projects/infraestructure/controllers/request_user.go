@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// tokenUserId devuelve el id de usuario del token validado por AuthMiddleware, o 0 si no hay token
+func tokenUserId(ctx *gin.Context) int {
+	value, ok := ctx.Get("userID")
+	if !ok {
+		return 0
+	}
+	switch v := value.(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	default:
+		if id, err := strconv.Atoi(fmt.Sprint(v)); err == nil {
+			return id
+		}
+	}
+	return 0
+}
+
+// parseAdminUserIds interpreta la lista de ids de administradores separados por comas
+func parseAdminUserIds(adminUserIds string) map[int]bool {
+	admins := make(map[int]bool)
+	for _, part := range strings.Split(adminUserIds, ",") {
+		if id, err := strconv.Atoi(strings.TrimSpace(part)); err == nil && id > 0 {
+			admins[id] = true
+		}
+	}
+	return admins
+}
+
+// RequireAdmin deja pasar solo a los usuarios de adminUserIds (ids separados por comas).
+// Va después de AuthMiddleware porque únicamente confía en el usuario del token
+func RequireAdmin(adminUserIds string) gin.HandlerFunc {
+	admins := parseAdminUserIds(adminUserIds)
+
+	return func(ctx *gin.Context) {
+		if !admins[tokenUserId(ctx)] {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Se requieren permisos de administrador", "success": false})
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// IdentifyAdmin marca en el contexto si el usuario del token es administrador, para las rutas que
+// aplican reglas de propiedad sin estar restringidas a administradores. Va después de AuthMiddleware
+func IdentifyAdmin(adminUserIds string) gin.HandlerFunc {
+	admins := parseAdminUserIds(adminUserIds)
+
+	return func(ctx *gin.Context) {
+		ctx.Set("isAdmin", admins[tokenUserId(ctx)])
+		ctx.Next()
+	}
+}
+
+// requestIsAdmin indica si IdentifyAdmin reconoció al usuario como administrador
+func requestIsAdmin(ctx *gin.Context) bool {
+	return ctx.GetBool("isAdmin")
+}
@@ -0,0 +1,49 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/adapters"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// TestTokenUserId comprueba que el usuario de un token emitido por el login llega a los controladores
+// a través de AuthMiddleware, y que IdentifyAdmin lo reconoce
+func TestTokenUserId(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	const secret = "secreto-de-prueba"
+	token, err := (&adapters.JWTManager{SecretKey: secret}).GenerateToken(7)
+	if err != nil {
+		t.Fatalf("error al generar el token: %v", err)
+	}
+
+	var userId int
+	var isAdmin bool
+	router := gin.New()
+	router.GET("/", auth.AuthMiddleware(secret), IdentifyAdmin("3,7"), func(ctx *gin.Context) {
+		userId, isAdmin = tokenUserId(ctx), requestIsAdmin(ctx)
+		ctx.Status(http.StatusNoContent)
+	})
+	router.GET("/admin", auth.AuthMiddleware(secret), RequireAdmin("3"), func(ctx *gin.Context) {
+		ctx.Status(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent || userId != 7 || !isAdmin {
+		t.Errorf("respuesta %d, usuario %d, administrador %t; se esperaba 204, 7 y true", rec.Code, userId, isAdmin)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/admin", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("un usuario que no es administrador debería recibir 403, obtenido %d", rec.Code)
+	}
+}
@@ -0,0 +1,44 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type RestoreProjectRevisionController struct {
+	useCase *application.RestoreProjectRevisionUseCase
+}
+
+func NewRestoreProjectRevisionController(useCase *application.RestoreProjectRevisionUseCase) *RestoreProjectRevisionController {
+	return &RestoreProjectRevisionController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/:id/history/:revision/restore con la cabecera If-Match
+func (c *RestoreProjectRevisionController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	revision, err := strconv.Atoi(ctx.Param("revision"))
+	if err != nil || revision <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Número de revisión inválido", "success": false})
+		return
+	}
+
+	expectedVersion, ok := requireIfMatch(ctx, id)
+	if !ok {
+		return
+	}
+
+	project, err := c.useCase.Execute(id, revision, expectedVersion, tokenUserId(ctx), requestIsAdmin(ctx))
+	if err != nil {
+		respondQueryError(ctx, err, "Error al restaurar la revisión")
+		return
+	}
+
+	respondProject(ctx, http.StatusOK, project, "Proyecto restaurado a la revisión "+strconv.Itoa(revision))
+}
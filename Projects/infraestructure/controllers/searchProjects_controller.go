@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type SearchProjectsController struct {
+	useCase *application.SearchProjectsUseCase
+}
+
+func NewSearchProjectsController(useCase *application.SearchProjectsUseCase) *SearchProjectsController {
+	return &SearchProjectsController{useCase: useCase}
+}
+
+func (c *SearchProjectsController) Execute(ctx *gin.Context) {
+	query := entities.ProjectSearchQuery{Text: ctx.Query("q")}
+
+	if userIdStr := ctx.Query("userId"); userIdStr != "" {
+		userId, err := strconv.Atoi(userIdStr)
+		if err != nil || userId <= 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "El userId debe ser un número mayor a 0", "success": false})
+			return
+		}
+		query.UserId = userId
+	}
+
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "El limit debe ser un número mayor a 0", "success": false})
+			return
+		}
+		query.Limit = limit
+	}
+
+	results, err := c.useCase.Execute(query)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidFilter) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Error al buscar proyectos: " + err.Error(), "success": false})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    results,
+	})
+}
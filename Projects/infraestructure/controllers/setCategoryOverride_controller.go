@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type SetCategoryOverrideController struct {
+	useCase *application.SetCategoryOverrideUseCase
+}
+
+func NewSetCategoryOverrideController(useCase *application.SetCategoryOverrideUseCase) *SetCategoryOverrideController {
+	return &SetCategoryOverrideController{useCase: useCase}
+}
+
+// Execute maneja PUT /categories/:id/overrides/:orgId con {nombre?, color?, icon?, hidden?}
+func (c *SetCategoryOverrideController) Execute(ctx *gin.Context) {
+	categoryId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	orgId, err := strconv.Atoi(ctx.Param("orgId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "orgId inválido", "success": false})
+		return
+	}
+
+	var override entities.CategoryOverride
+	if err := ctx.ShouldBindJSON(&override); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+		return
+	}
+	override.CategoryId = categoryId
+	override.OrgId = orgId
+
+	if err := c.useCase.Execute(override); err != nil {
+		respondQueryError(ctx, err, "Error al guardar la personalización")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    override,
+	})
+}
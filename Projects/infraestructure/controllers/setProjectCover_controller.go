@@ -0,0 +1,42 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type SetProjectCoverController struct {
+	useCase *application.SetProjectCoverUseCase
+}
+
+func NewSetProjectCoverController(useCase *application.SetProjectCoverUseCase) *SetProjectCoverController {
+	return &SetProjectCoverController{useCase: useCase}
+}
+
+// Execute maneja PUT /projects/:id/media/:mediaId/cover
+func (c *SetProjectCoverController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	mediaId, err := strconv.Atoi(ctx.Param("mediaId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID de imagen inválido", "success": false})
+		return
+	}
+
+	media, err := c.useCase.Execute(id, mediaId, tokenUserId(ctx))
+	if err != nil {
+		respondQueryError(ctx, err, "Error al cambiar la portada")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    media,
+	})
+}
@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+	"github.com/gin-gonic/gin"
+)
+
+// maxGeometryBodyBytes limita el tamaño de la geometría recibida
+const maxGeometryBodyBytes = 2 << 20
+
+type SetProjectGeometryController struct {
+	useCase *application.SetProjectGeometryUseCase
+}
+
+func NewSetProjectGeometryController(useCase *application.SetProjectGeometryUseCase) *SetProjectGeometryController {
+	return &SetProjectGeometryController{useCase: useCase}
+}
+
+// Execute maneja PUT /projects/:id/geometry?crs= con una geometría GeoJSON (geometry o Feature) o un
+// texto WKT en el cuerpo y la cabecera If-Match. Las coordenadas en otro CRS se reproyectan a WGS84
+func (c *SetProjectGeometryController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	expectedVersion, ok := requireIfMatch(ctx, id)
+	if !ok {
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxGeometryBodyBytes))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "No se pudo leer la geometría: " + err.Error(), "success": false})
+		return
+	}
+	crs, err := inputCRS(ctx)
+	if err != nil {
+		respondQueryError(ctx, err, "Sistema de referencia inválido")
+		return
+	}
+	geometry, err := services.ParseGeometryInCRS(string(body), crs)
+	if err != nil {
+		respondQueryError(ctx, err, "Geometría inválida")
+		return
+	}
+
+	project, err := c.useCase.Execute(id, geometry, expectedVersion, tokenUserId(ctx))
+	if err != nil {
+		respondQueryError(ctx, err, "Error al guardar la geometría del proyecto")
+		return
+	}
+
+	respondProject(ctx, http.StatusOK, project, "")
+}
@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+type TransitionProjectStatusController struct {
+	useCase *application.TransitionProjectStatusUseCase
+}
+
+func NewTransitionProjectStatusController(useCase *application.TransitionProjectStatusUseCase) *TransitionProjectStatusController {
+	return &TransitionProjectStatusController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/:id/status con {"status": "in_field", "comment": "Cuadrilla 2"} y la
+// cabecera If-Match
+func (c *TransitionProjectStatusController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	expectedVersion, ok := requireIfMatch(ctx, id)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Status  string `json:"status"`
+		Comment string `json:"comment"`
+	}
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+		return
+	}
+
+	project, err := c.useCase.Execute(id, body.Status, body.Comment, expectedVersion, tokenUserId(ctx))
+	if err != nil {
+		respondQueryError(ctx, err, "Error al cambiar el estado del proyecto")
+		return
+	}
+
+	respondProject(ctx, http.StatusOK, project, "")
+}
@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type UpdateCategoryController struct {
+	useCase *application.UpdateCategoryUseCase
+}
+
+func NewUpdateCategoryController(useCase *application.UpdateCategoryUseCase) *UpdateCategoryController {
+	return &UpdateCategoryController{useCase: useCase}
+}
+
+// Execute maneja PUT /categories/:id con {nombre, slug?, color?, icon?}
+func (c *UpdateCategoryController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	var category entities.Category
+	if err := ctx.ShouldBindJSON(&category); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+		return
+	}
+	category.Id = id
+
+	updated, err := c.useCase.Execute(category)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al actualizar la categoría")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    updated,
+	})
+}
@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type UpdateMeasurementController struct {
+	useCase *application.UpdateMeasurementUseCase
+}
+
+func NewUpdateMeasurementController(useCase *application.UpdateMeasurementUseCase) *UpdateMeasurementController {
+	return &UpdateMeasurementController{useCase: useCase}
+}
+
+// Execute maneja PUT /projects/:id/measurements/:measurementId
+func (c *UpdateMeasurementController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	measurementId, err := strconv.Atoi(ctx.Param("measurementId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID de punto inválido", "success": false})
+		return
+	}
+
+	var measurement entities.Measurement
+	if err := ctx.ShouldBindJSON(&measurement); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+		return
+	}
+	measurement.Id = measurementId
+	measurement.ProjectId = id
+
+	updated, err := c.useCase.Execute(measurement)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al actualizar el punto")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    updated,
+	})
+}
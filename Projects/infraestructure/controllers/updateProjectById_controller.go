@@ -10,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
 	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
 )
 
 type UpdateProjectController struct {
@@ -29,6 +30,12 @@ func (c *UpdateProjectController) Execute(ctx *gin.Context) {
 		return
 	}
 
+	// Versión que conoce el cliente, para no sobrescribir cambios de otro editor
+	expectedVersion, ok := requireIfMatch(ctx, id)
+	if !ok {
+		return
+	}
+
 	// Debug: Ver todos los campos recibidos
 	fmt.Printf("DEBUG UpdateProject - ID: %d\n", id)
 	fmt.Printf("  nombreProyecto: %s\n", ctx.PostForm("nombreProyecto"))
@@ -41,8 +48,19 @@ func (c *UpdateProjectController) Execute(ctx *gin.Context) {
 
 	var project entities.Project
 	project.Id = id
+	project.Version = expectedVersion
 	project.NombreProyecto = ctx.PostForm("nombreProyecto")
-	project.Fecha = ctx.PostForm("fecha")
+	loc, err := entities.LoadTimezone(ctx.PostForm("tz"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	fecha, err := entities.ParseProjectDate(ctx.PostForm("fecha"), loc)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	project.Fecha = fecha
 	project.Categoria = ctx.PostForm("categoria")
 	project.Descripcion = ctx.PostForm("descripcion")
 	
@@ -51,7 +69,16 @@ func (c *UpdateProjectController) Execute(ctx *gin.Context) {
 	if project.NombreProyecto == "" {
 		return
 	}
-	if project.Categoria == "" {
+	// La categoría se indica por su id en el catálogo o por su nombre o slug
+	if categoryIdStr := ctx.PostForm("categoryId"); categoryIdStr != "" {
+		categoryId, err := strconv.Atoi(categoryIdStr)
+		if err != nil || categoryId <= 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "El categoryId debe ser un número mayor a 0"})
+			return
+		}
+		project.CategoryId = categoryId
+	}
+	if project.Categoria == "" && project.CategoryId == 0 {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "La categoría es obligatoria"})
 		return
 	}
@@ -99,6 +126,27 @@ func (c *UpdateProjectController) Execute(ctx *gin.Context) {
 		project.Lng = lng
 	}
 
+	// Coordenadas x/y y geometría en otro sistema de referencia (crs=EPSG:32614); se reproyectan a WGS84
+	crs, err := inputCRS(ctx)
+	if err != nil {
+		respondQueryError(ctx, err, "Sistema de referencia inválido")
+		return
+	}
+	if err := readProjectedLocation(ctx, crs, &project); err != nil {
+		respondQueryError(ctx, err, "Coordenadas inválidas")
+		return
+	}
+
+	// Área levantada (opcional): polígono o línea en GeoJSON o WKT; su centroide reemplaza lat/lng
+	if geometryStr := ctx.PostForm("geometry"); geometryStr != "" {
+		geometry, err := services.ParseGeometryInCRS(geometryStr, crs)
+		if err != nil {
+			respondQueryError(ctx, err, "Geometría inválida")
+			return
+		}
+		project.Geometry = geometry
+	}
+
 	// Manejo de imagen (opcional en update)
 	var imagePath string
 	file, err := ctx.FormFile("img")
@@ -113,14 +161,23 @@ func (c *UpdateProjectController) Execute(ctx *gin.Context) {
 		defer os.Remove(imagePath)
 	}
 
+	// Imágenes nuevas para la galería
+	media, err := saveMediaUploads(ctx, loc)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al guardar las imágenes temporales")
+		return
+	}
+	defer removeMediaUploads(media)
+
 	// Debug final antes del use case
 	fmt.Printf("DEBUG: Proyecto completo antes del use case: %+v\n", project)
 
 	// Ejecutar use case
-	if err := c.useCase.Execute(project, imagePath); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Error al actualizar proyecto: " + err.Error()})
+	updated, err := c.useCase.Execute(project, imagePath, media, tokenUserId(ctx), requestIsAdmin(ctx))
+	if err != nil {
+		respondQueryError(ctx, err, "Error al actualizar proyecto")
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{"message": "Proyecto actualizado exitosamente"})
+	respondProject(ctx, http.StatusOK, updated, "Proyecto actualizado exitosamente")
 }
\ No newline at end of file
@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type UpdateProjectTemplateController struct {
+	useCase *application.UpdateProjectTemplateUseCase
+}
+
+func NewUpdateProjectTemplateController(useCase *application.UpdateProjectTemplateUseCase) *UpdateProjectTemplateController {
+	return &UpdateProjectTemplateController{useCase: useCase}
+}
+
+// Execute maneja PUT /projects/templates/:templateId
+func (c *UpdateProjectTemplateController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("templateId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	var template entities.ProjectTemplate
+	if err := ctx.ShouldBindJSON(&template); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "JSON inválido: " + err.Error(), "success": false})
+		return
+	}
+	template.Id = id
+
+	updated, err := c.useCase.Execute(template, tokenUserId(ctx), requestIsAdmin(ctx))
+	if err != nil {
+		respondQueryError(ctx, err, "Error al actualizar la plantilla")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    updated,
+	})
+}
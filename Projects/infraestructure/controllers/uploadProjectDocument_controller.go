@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/gin-gonic/gin"
+)
+
+// documentFormOverhead es el margen para los demás campos del formulario multipart
+const documentFormOverhead = 1 << 20
+
+type UploadProjectDocumentController struct {
+	useCase *application.UploadProjectDocumentUseCase
+}
+
+func NewUploadProjectDocumentController(useCase *application.UploadProjectDocumentUseCase) *UploadProjectDocumentController {
+	return &UploadProjectDocumentController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/:id/documents (multipart con el archivo en el campo file)
+func (c *UploadProjectDocumentController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, c.useCase.MaxSize()+documentFormOverhead)
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "El archivo supera el tamaño máximo permitido", "success": false})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Se requiere un archivo en el campo file", "success": false})
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Error al leer el archivo: " + err.Error(), "success": false})
+		return
+	}
+	defer file.Close()
+
+	document, err := c.useCase.Execute(id, header.Filename, header.Size, file, tokenUserId(ctx))
+	if err != nil {
+		respondQueryError(ctx, err, "Error al adjuntar el documento")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    document,
+	})
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/gin-gonic/gin"
+)
+
+type UploadProjectMediaController struct {
+	useCase *application.UploadProjectMediaUseCase
+}
+
+func NewUploadProjectMediaController(useCase *application.UploadProjectMediaUseCase) *UploadProjectMediaController {
+	return &UploadProjectMediaController{useCase: useCase}
+}
+
+// Execute maneja POST /projects/:id/media (multipart con uno o varios archivos media)
+func (c *UploadProjectMediaController) Execute(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido", "success": false})
+		return
+	}
+	loc, err := entities.LoadTimezone(ctx.PostForm("tz"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "success": false})
+		return
+	}
+
+	uploads, err := saveMediaUploads(ctx, loc)
+	if err != nil {
+		respondQueryError(ctx, err, "Error al guardar las imágenes temporales")
+		return
+	}
+	defer removeMediaUploads(uploads)
+
+	media, err := c.useCase.Execute(id, uploads, tokenUserId(ctx))
+	if err != nil {
+		respondQueryError(ctx, err, "Error al subir las imágenes")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    media,
+	})
+}
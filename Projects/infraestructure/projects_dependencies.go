@@ -3,8 +3,12 @@ package infraestructure
 
 import (
 	"log"
+	"os"
+	"strconv"
+	"time"
 
 	app_projects "github.com/JosephAntony37900/Geova-back-1/Projects/application"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
 	domain_projects "github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
 	domain_services "github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
 	control_projects "github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
@@ -18,9 +22,21 @@ import (
 
 // ProjectInfrastructure encapsula toda la infraestructura de proyectos
 type ProjectInfrastructure struct {
-	DB          *core.Conn_MySQL
-	ProjectRepo domain_projects.ProjectRepository
-	WorkerSrv   *domain_services.ImageUploadWorkerService
+	DB           *core.Conn_MySQL
+	ProjectRepo  domain_projects.ProjectRepository
+	SearchRepo   domain_projects.ProjectSearchRepository
+	RevisionRepo domain_projects.ProjectRevisionRepository
+	CategoryRepo domain_projects.CategoryRepository
+	TagRepo      domain_projects.TagRepository
+	MediaRepo    domain_projects.ProjectMediaRepository
+	DocumentRepo domain_projects.ProjectDocumentRepository
+	PointRepo    domain_projects.MeasurementRepository
+	StatusRepo   domain_projects.ProjectStatusRepository
+	TemplateRepo domain_projects.ProjectTemplateRepository
+	BulkRepo     domain_projects.ProjectBulkRepository
+	WorkerSrv    *domain_services.ImageUploadWorkerService
+	GeocodeSrv   *domain_services.GeocodingWorkerService
+	ImportSrv    *domain_services.ImportJobService
 }
 
 // NewProjectInfrastructure crea e inicializa toda la infraestructura de proyectos
@@ -36,10 +52,30 @@ func NewProjectInfrastructure() *ProjectInfrastructure {
 
 	// Crear repositorio
 	projectRepo := repo_projects.NewProjectMySQLRepository(db)
+	searchRepo := repo_projects.NewProjectMySQLSearchRepository(db)
+	revisionRepo := repo_projects.NewProjectRevisionMySQLRepository(db)
+	categoryRepo := repo_projects.NewCategoryMySQLRepository(db)
+	tagRepo := repo_projects.NewTagMySQLRepository(db)
+	mediaRepo := repo_projects.NewProjectMediaMySQLRepository(db)
+	documentRepo := repo_projects.NewProjectDocumentMySQLRepository(db)
+	pointRepo := repo_projects.NewMeasurementMySQLRepository(db)
+	statusRepo := repo_projects.NewProjectStatusMySQLRepository(db)
+	templateRepo := repo_projects.NewProjectTemplateMySQLRepository(db)
+	bulkRepo := repo_projects.NewProjectBulkMySQLRepository(db)
 
 	return &ProjectInfrastructure{
-		DB:          db,
-		ProjectRepo: projectRepo,
+		DB:           db,
+		ProjectRepo:  projectRepo,
+		SearchRepo:   searchRepo,
+		RevisionRepo: revisionRepo,
+		CategoryRepo: categoryRepo,
+		TagRepo:      tagRepo,
+		MediaRepo:    mediaRepo,
+		DocumentRepo: documentRepo,
+		PointRepo:    pointRepo,
+		StatusRepo:   statusRepo,
+		TemplateRepo: templateRepo,
+		BulkRepo:     bulkRepo,
 	}
 }
 
@@ -65,20 +101,87 @@ func InitProjectDependencies(engine *gin.Engine) *ProjectInfrastructure {
 	infrastructure.WorkerSrv = workerService
 	log.Println("INFO: ImageUploadWorkerService inicializado exitosamente")
 
+	documentStorage := newDocumentStorage(cloudinaryAdapter)
+
+	// La geocodificación inversa es opcional; sin geocodificador los proyectos quedan sin dirección
+	geocoder := newGeocoder()
+	var geocodeService *domain_services.GeocodingWorkerService
+	if geocoder != nil {
+		geocodeService = domain_services.NewGeocodingWorkerService(geocoder, infrastructure.ProjectRepo.UpdateAddress, 1, 1000)
+		infrastructure.GeocodeSrv = geocodeService
+	}
+
+	// Las importaciones CSV grandes se procesan de una en una en segundo plano
+	importService := domain_services.NewImportJobService(1, 20, time.Hour)
+	infrastructure.ImportSrv = importService
+
+	statusWorkflow := newStatusWorkflow()
+
 	// Crear casos de uso
 	log.Println("INFO: Inicializando casos de uso...")
-	createProjectUseCase := app_projects.NewCreateProjectUseCase(infrastructure.ProjectRepo, cloudinaryAdapter, workerService)
+	createProjectUseCase := app_projects.NewCreateProjectUseCase(infrastructure.ProjectRepo, infrastructure.CategoryRepo, infrastructure.MediaRepo, infrastructure.TemplateRepo, cloudinaryAdapter, workerService, geocodeService)
 	getAllProjectsUseCase := app_projects.NewGeProjectsUseCase(infrastructure.ProjectRepo)
 	getProjectByIdUseCase := app_projects.NewGetProjectByIdUseCase(infrastructure.ProjectRepo)
 	getProjectByNameUseCase := app_projects.NewGetProjectsByNameUseCase(infrastructure.ProjectRepo)
 	getProjectByCategoryUseCase := app_projects.NewGetProjectsByCategoryUseCase(infrastructure.ProjectRepo)
 	getProjectByDateUseCase := app_projects.NewGetProjectsByDateUseCase(infrastructure.ProjectRepo)
 	getProjectStatsUseCase := app_projects.NewGetProjectStatsUseCase(infrastructure.ProjectRepo)
-	updateProjectUseCase := app_projects.NewUpdateProjectUseCase(infrastructure.ProjectRepo, cloudinaryAdapter, workerService)
-	deleteProjectUseCase := app_projects.NewDeleteProjectUseCase(infrastructure.ProjectRepo)
+	updateProjectUseCase := app_projects.NewUpdateProjectUseCase(infrastructure.ProjectRepo, infrastructure.CategoryRepo, infrastructure.MediaRepo, cloudinaryAdapter, workerService, geocodeService)
+	deleteProjectUseCase := app_projects.NewDeleteProjectUseCase(infrastructure.ProjectRepo, infrastructure.DocumentRepo, documentStorage)
 	getProjectsByUserIdUseCase := app_projects.NewGetProjectsByUserIdUseCase(infrastructure.ProjectRepo)
 	getTotalProjectsByUserUseCase := app_projects.NewGetTotalProjectsByUserUseCase(infrastructure.ProjectRepo)
-
+	searchProjectsUseCase := app_projects.NewSearchProjectsUseCase(infrastructure.SearchRepo)
+	getProjectsNearUseCase := app_projects.NewGetProjectsNearUseCase(infrastructure.ProjectRepo)
+	getProjectsWithinUseCase := app_projects.NewGetProjectsWithinUseCase(infrastructure.ProjectRepo)
+	getNearestProjectsUseCase := app_projects.NewGetNearestProjectsUseCase(infrastructure.ProjectRepo)
+	exportProjectsGeoJSONUseCase := app_projects.NewExportProjectsGeoJSONUseCase(infrastructure.ProjectRepo)
+	importProjectsGeoJSONUseCase := app_projects.NewImportProjectsGeoJSONUseCase(infrastructure.ProjectRepo, infrastructure.CategoryRepo, geocodeService)
+	exportProjectsKMLUseCase := app_projects.NewExportProjectsKMLUseCase(infrastructure.ProjectRepo, infrastructure.CategoryRepo)
+	exportProjectsGPXUseCase := app_projects.NewExportProjectsGPXUseCase(infrastructure.ProjectRepo)
+	exportProjectsSpreadsheetUseCase := app_projects.NewExportProjectsSpreadsheetUseCase(infrastructure.ProjectRepo)
+	getProjectHistoryUseCase := app_projects.NewGetProjectHistoryUseCase(infrastructure.ProjectRepo, infrastructure.RevisionRepo)
+	diffProjectRevisionsUseCase := app_projects.NewDiffProjectRevisionsUseCase(infrastructure.RevisionRepo)
+	restoreProjectRevisionUseCase := app_projects.NewRestoreProjectRevisionUseCase(infrastructure.ProjectRepo, infrastructure.RevisionRepo, infrastructure.CategoryRepo, geocodeService)
+	getCategoriesUseCase := app_projects.NewGetCategoriesUseCase(infrastructure.CategoryRepo)
+	createCategoryUseCase := app_projects.NewCreateCategoryUseCase(infrastructure.CategoryRepo)
+	updateCategoryUseCase := app_projects.NewUpdateCategoryUseCase(infrastructure.CategoryRepo)
+	deleteCategoryUseCase := app_projects.NewDeleteCategoryUseCase(infrastructure.CategoryRepo)
+	setCategoryOverrideUseCase := app_projects.NewSetCategoryOverrideUseCase(infrastructure.CategoryRepo)
+	deleteCategoryOverrideUseCase := app_projects.NewDeleteCategoryOverrideUseCase(infrastructure.CategoryRepo)
+	getProjectTagsUseCase := app_projects.NewGetProjectTagsUseCase(infrastructure.ProjectRepo, infrastructure.TagRepo)
+	addProjectTagsUseCase := app_projects.NewAddProjectTagsUseCase(infrastructure.ProjectRepo, infrastructure.TagRepo)
+	removeProjectTagUseCase := app_projects.NewRemoveProjectTagUseCase(infrastructure.TagRepo)
+	autocompleteTagsUseCase := app_projects.NewAutocompleteTagsUseCase(infrastructure.TagRepo)
+	getProjectMediaUseCase := app_projects.NewGetProjectMediaUseCase(infrastructure.ProjectRepo, infrastructure.MediaRepo)
+	uploadProjectMediaUseCase := app_projects.NewUploadProjectMediaUseCase(infrastructure.ProjectRepo, infrastructure.MediaRepo, workerService)
+	reorderProjectMediaUseCase := app_projects.NewReorderProjectMediaUseCase(infrastructure.ProjectRepo, infrastructure.MediaRepo)
+	setProjectCoverUseCase := app_projects.NewSetProjectCoverUseCase(infrastructure.MediaRepo)
+	deleteProjectMediaUseCase := app_projects.NewDeleteProjectMediaUseCase(infrastructure.MediaRepo)
+	getProjectDocumentsUseCase := app_projects.NewGetProjectDocumentsUseCase(infrastructure.ProjectRepo, infrastructure.DocumentRepo)
+	uploadProjectDocumentUseCase := app_projects.NewUploadProjectDocumentUseCase(infrastructure.ProjectRepo, infrastructure.DocumentRepo, documentStorage, documentMaxSize())
+	downloadProjectDocumentUseCase := app_projects.NewDownloadProjectDocumentUseCase(infrastructure.DocumentRepo, documentStorage)
+	deleteProjectDocumentUseCase := app_projects.NewDeleteProjectDocumentUseCase(infrastructure.DocumentRepo, documentStorage)
+	getMeasurementsUseCase := app_projects.NewGetMeasurementsUseCase(infrastructure.ProjectRepo, infrastructure.PointRepo)
+	addMeasurementsUseCase := app_projects.NewAddMeasurementsUseCase(infrastructure.ProjectRepo, infrastructure.PointRepo)
+	updateMeasurementUseCase := app_projects.NewUpdateMeasurementUseCase(infrastructure.PointRepo)
+	deleteMeasurementUseCase := app_projects.NewDeleteMeasurementUseCase(infrastructure.PointRepo)
+	setProjectGeometryUseCase := app_projects.NewSetProjectGeometryUseCase(infrastructure.ProjectRepo, geocodeService)
+	patchProjectUseCase := app_projects.NewPatchProjectUseCase(infrastructure.ProjectRepo, infrastructure.CategoryRepo, geocodeService)
+	geocodeProjectUseCase := app_projects.NewGeocodeProjectUseCase(infrastructure.ProjectRepo, geocoder)
+	getProjectStatusWorkflowUseCase := app_projects.NewGetProjectStatusWorkflowUseCase(statusWorkflow)
+	transitionProjectStatusUseCase := app_projects.NewTransitionProjectStatusUseCase(infrastructure.ProjectRepo, infrastructure.StatusRepo, statusWorkflow)
+	getProjectStatusHistoryUseCase := app_projects.NewGetProjectStatusHistoryUseCase(infrastructure.ProjectRepo, infrastructure.StatusRepo)
+	getProjectTemplatesUseCase := app_projects.NewGetProjectTemplatesUseCase(infrastructure.TemplateRepo)
+	getProjectTemplateUseCase := app_projects.NewGetProjectTemplateUseCase(infrastructure.TemplateRepo)
+	createProjectTemplateUseCase := app_projects.NewCreateProjectTemplateUseCase(infrastructure.TemplateRepo, infrastructure.CategoryRepo)
+	updateProjectTemplateUseCase := app_projects.NewUpdateProjectTemplateUseCase(infrastructure.TemplateRepo, infrastructure.CategoryRepo)
+	deleteProjectTemplateUseCase := app_projects.NewDeleteProjectTemplateUseCase(infrastructure.TemplateRepo)
+	cloneProjectUseCase := app_projects.NewCloneProjectUseCase(infrastructure.ProjectRepo, infrastructure.TagRepo, infrastructure.PointRepo, infrastructure.MediaRepo, geocodeService)
+	importProjectsCSVUseCase := app_projects.NewImportProjectsCSVUseCase(infrastructure.ProjectRepo, infrastructure.CategoryRepo, importService, geocodeService)
+	getImportJobUseCase := app_projects.NewGetImportJobUseCase(importService)
+	getProjectClustersUseCase := app_projects.NewGetProjectClustersUseCase(infrastructure.ProjectRepo)
+	generateProjectReportUseCase := app_projects.NewGenerateProjectReportUseCase(infrastructure.ProjectRepo, infrastructure.MediaRepo, infrastructure.PointRepo, infrastructure.TagRepo, services_projects.NewHTTPImageLoader(15*time.Second))
+	bulkProjectsUseCase := app_projects.NewBulkProjectsUseCase(infrastructure.ProjectRepo, infrastructure.BulkRepo, infrastructure.CategoryRepo, statusWorkflow, infrastructure.DocumentRepo, documentStorage)
 
 	// Crear controladores
 	log.Println("INFO: Inicializando controladores...")
@@ -93,10 +196,65 @@ func InitProjectDependencies(engine *gin.Engine) *ProjectInfrastructure {
 	deleteProjectController := control_projects.NewDeleteProjectController(deleteProjectUseCase)
 	getProjectsByUserIdController := control_projects.NewGetProjectsByUserIdController(getProjectsByUserIdUseCase)
 	getTotalProjectsByUserController := control_projects.NewGetTotalProjectsByUserController(getTotalProjectsByUserUseCase)
+	searchProjectsController := control_projects.NewSearchProjectsController(searchProjectsUseCase)
+	getProjectsNearController := control_projects.NewGetProjectsNearController(getProjectsNearUseCase)
+	getProjectsWithinController := control_projects.NewGetProjectsWithinController(getProjectsWithinUseCase)
+	getNearestProjectsController := control_projects.NewGetNearestProjectsController(getNearestProjectsUseCase)
+	exportProjectsGeoJSONController := control_projects.NewExportProjectsGeoJSONController(exportProjectsGeoJSONUseCase)
+	importProjectsGeoJSONController := control_projects.NewImportProjectsGeoJSONController(importProjectsGeoJSONUseCase)
+	exportProjectsKMLController := control_projects.NewExportProjectsKMLController(exportProjectsKMLUseCase)
+	exportProjectsGPXController := control_projects.NewExportProjectsGPXController(exportProjectsGPXUseCase)
+	exportProjectsSpreadsheetController := control_projects.NewExportProjectsSpreadsheetController(exportProjectsSpreadsheetUseCase)
+	getProjectHistoryController := control_projects.NewGetProjectHistoryController(getProjectHistoryUseCase)
+	diffProjectRevisionsController := control_projects.NewDiffProjectRevisionsController(diffProjectRevisionsUseCase)
+	restoreProjectRevisionController := control_projects.NewRestoreProjectRevisionController(restoreProjectRevisionUseCase)
+	patchProjectController := control_projects.NewPatchProjectController(patchProjectUseCase)
+	getCategoriesController := control_projects.NewGetCategoriesController(getCategoriesUseCase)
+	createCategoryController := control_projects.NewCreateCategoryController(createCategoryUseCase)
+	updateCategoryController := control_projects.NewUpdateCategoryController(updateCategoryUseCase)
+	deleteCategoryController := control_projects.NewDeleteCategoryController(deleteCategoryUseCase)
+	setCategoryOverrideController := control_projects.NewSetCategoryOverrideController(setCategoryOverrideUseCase)
+	deleteCategoryOverrideController := control_projects.NewDeleteCategoryOverrideController(deleteCategoryOverrideUseCase)
+	getProjectTagsController := control_projects.NewGetProjectTagsController(getProjectTagsUseCase)
+	addProjectTagsController := control_projects.NewAddProjectTagsController(addProjectTagsUseCase)
+	removeProjectTagController := control_projects.NewRemoveProjectTagController(removeProjectTagUseCase)
+	autocompleteTagsController := control_projects.NewAutocompleteTagsController(autocompleteTagsUseCase)
+	getProjectMediaController := control_projects.NewGetProjectMediaController(getProjectMediaUseCase)
+	uploadProjectMediaController := control_projects.NewUploadProjectMediaController(uploadProjectMediaUseCase)
+	reorderProjectMediaController := control_projects.NewReorderProjectMediaController(reorderProjectMediaUseCase)
+	setProjectCoverController := control_projects.NewSetProjectCoverController(setProjectCoverUseCase)
+	deleteProjectMediaController := control_projects.NewDeleteProjectMediaController(deleteProjectMediaUseCase)
+	getProjectDocumentsController := control_projects.NewGetProjectDocumentsController(getProjectDocumentsUseCase)
+	uploadProjectDocumentController := control_projects.NewUploadProjectDocumentController(uploadProjectDocumentUseCase)
+	downloadProjectDocumentController := control_projects.NewDownloadProjectDocumentController(downloadProjectDocumentUseCase)
+	deleteProjectDocumentController := control_projects.NewDeleteProjectDocumentController(deleteProjectDocumentUseCase)
+	getMeasurementsController := control_projects.NewGetMeasurementsController(getMeasurementsUseCase)
+	addMeasurementsController := control_projects.NewAddMeasurementsController(addMeasurementsUseCase)
+	updateMeasurementController := control_projects.NewUpdateMeasurementController(updateMeasurementUseCase)
+	deleteMeasurementController := control_projects.NewDeleteMeasurementController(deleteMeasurementUseCase)
+	setProjectGeometryController := control_projects.NewSetProjectGeometryController(setProjectGeometryUseCase)
+	deleteProjectGeometryController := control_projects.NewDeleteProjectGeometryController(setProjectGeometryUseCase)
+	geocodeProjectController := control_projects.NewGeocodeProjectController(geocodeProjectUseCase)
+	getProjectStatusWorkflowController := control_projects.NewGetProjectStatusWorkflowController(getProjectStatusWorkflowUseCase)
+	transitionProjectStatusController := control_projects.NewTransitionProjectStatusController(transitionProjectStatusUseCase)
+	getProjectStatusHistoryController := control_projects.NewGetProjectStatusHistoryController(getProjectStatusHistoryUseCase)
+	getProjectTemplatesController := control_projects.NewGetProjectTemplatesController(getProjectTemplatesUseCase)
+	getProjectTemplateController := control_projects.NewGetProjectTemplateController(getProjectTemplateUseCase)
+	createProjectTemplateController := control_projects.NewCreateProjectTemplateController(createProjectTemplateUseCase)
+	updateProjectTemplateController := control_projects.NewUpdateProjectTemplateController(updateProjectTemplateUseCase)
+	deleteProjectTemplateController := control_projects.NewDeleteProjectTemplateController(deleteProjectTemplateUseCase)
+	cloneProjectController := control_projects.NewCloneProjectController(cloneProjectUseCase)
+	importProjectsCSVController := control_projects.NewImportProjectsCSVController(importProjectsCSVUseCase)
+	getImportJobController := control_projects.NewGetImportJobController(getImportJobUseCase)
+	getProjectClustersController := control_projects.NewGetProjectClustersController(getProjectClustersUseCase)
+	generateProjectReportController := control_projects.NewGenerateProjectReportController(generateProjectReportUseCase)
+	bulkProjectsController := control_projects.NewBulkProjectsController(bulkProjectsUseCase)
 
 	// Configurar rutas
 	log.Println("INFO: Configurando rutas de proyectos...")
-	routes_projects.SetUpProjectsRoutes(engine,
+	// Todas las rutas de proyectos comparten los mismos limitadores
+	limiters := routes_projects.NewProjectLimiters()
+	routes_projects.SetUpProjectsRoutes(engine, limiters,
 		createProjectController,
 		getAllProjectController,
 		getByIdProjectController,
@@ -107,12 +265,173 @@ func InitProjectDependencies(engine *gin.Engine) *ProjectInfrastructure {
 		updateProjectController,
 		deleteProjectController,
 		getProjectsByUserIdController,
-		getTotalProjectsByUserController,)
+		getTotalProjectsByUserController,
+		searchProjectsController,
+		getProjectsNearController,
+		getProjectsWithinController,
+		getNearestProjectsController,
+		exportProjectsGeoJSONController,
+		importProjectsGeoJSONController,
+		exportProjectsKMLController,
+		exportProjectsGPXController,
+		getProjectHistoryController,
+		diffProjectRevisionsController,
+		restoreProjectRevisionController,
+		patchProjectController,
+		exportProjectsSpreadsheetController,
+	)
+	routes_projects.SetUpCategoriesRoutes(engine, limiters,
+		getCategoriesController,
+		createCategoryController,
+		updateCategoryController,
+		deleteCategoryController,
+		setCategoryOverrideController,
+		deleteCategoryOverrideController,
+	)
+	routes_projects.SetUpTagsRoutes(engine, limiters,
+		getProjectTagsController,
+		addProjectTagsController,
+		removeProjectTagController,
+		autocompleteTagsController,
+	)
+	routes_projects.SetUpMediaRoutes(engine, limiters,
+		getProjectMediaController,
+		uploadProjectMediaController,
+		reorderProjectMediaController,
+		setProjectCoverController,
+		deleteProjectMediaController,
+	)
+	routes_projects.SetUpDocumentsRoutes(engine, limiters,
+		getProjectDocumentsController,
+		uploadProjectDocumentController,
+		downloadProjectDocumentController,
+		deleteProjectDocumentController,
+	)
+	routes_projects.SetUpMeasurementsRoutes(engine, limiters,
+		getMeasurementsController,
+		addMeasurementsController,
+		updateMeasurementController,
+		deleteMeasurementController,
+	)
+	routes_projects.SetUpGeometryRoutes(engine, limiters,
+		setProjectGeometryController,
+		deleteProjectGeometryController,
+	)
+	routes_projects.SetUpGeocodingRoutes(engine, limiters,
+		geocodeProjectController,
+	)
+	routes_projects.SetUpStatusRoutes(engine, limiters,
+		getProjectStatusWorkflowController,
+		transitionProjectStatusController,
+		getProjectStatusHistoryController,
+	)
+	routes_projects.SetUpTemplatesRoutes(engine, limiters,
+		getProjectTemplatesController,
+		getProjectTemplateController,
+		createProjectTemplateController,
+		updateProjectTemplateController,
+		deleteProjectTemplateController,
+		cloneProjectController,
+	)
+	routes_projects.SetUpBulkRoutes(engine, limiters, bulkProjectsController)
+	routes_projects.SetUpCSVImportRoutes(engine, limiters, importProjectsCSVController, getImportJobController)
+	routes_projects.SetUpReportRoutes(engine, limiters, generateProjectReportController)
+	routes_projects.SetUpMapRoutes(engine, limiters, getProjectClustersController)
 
 	log.Println("INFO: Infraestructura de proyectos inicializada exitosamente")
 	return infrastructure
 }
 
+// newDocumentStorage elige el almacenamiento de los adjuntos con DOCUMENTS_STORAGE:
+// "cloudinary" (recursos raw) o "local" (por defecto, en DOCUMENTS_LOCAL_DIR)
+func newDocumentStorage(cloudinaryAdapter *services_projects.CloudinaryAdapter) domain_services.IDocumentStorage {
+	if os.Getenv("DOCUMENTS_STORAGE") == "cloudinary" {
+		log.Println("INFO: Documentos adjuntos almacenados en Cloudinary")
+		return services_projects.NewCloudinaryDocumentStorage(cloudinaryAdapter)
+	}
+	dir := os.Getenv("DOCUMENTS_LOCAL_DIR")
+	if dir == "" {
+		dir = "storage/documents"
+	}
+	log.Printf("INFO: Documentos adjuntos almacenados en %s", dir)
+	return services_projects.NewLocalDocumentStorage(dir)
+}
+
+// newGeocoder elige el geocodificador con GEOCODER: "offline" (límites administrativos de municipios y
+// estados en GEOCODER_BOUNDARIES_FILE), "nominatim" (GEOCODER_URL, por defecto el servicio público de
+// OpenStreetMap) o "none". Sin GEOCODER se usa "offline" si hay un archivo de límites configurado y si no
+// se desactiva la geocodificación. Las respuestas se guardan en caché GEOCODER_CACHE_TTL
+func newGeocoder() domain_services.IGeocoder {
+	mode := os.Getenv("GEOCODER")
+	if mode == "" && os.Getenv("GEOCODER_BOUNDARIES_FILE") != "" {
+		mode = "offline"
+	}
+
+	var geocoder domain_services.IGeocoder
+	switch mode {
+	case "", "none":
+		log.Println("INFO: Geocodificación inversa desactivada")
+		return nil
+	case "nominatim":
+		baseURL := os.Getenv("GEOCODER_URL")
+		if baseURL == "" {
+			baseURL = "https://nominatim.openstreetmap.org"
+		}
+		userAgent := os.Getenv("GEOCODER_USER_AGENT")
+		if userAgent == "" {
+			userAgent = "geova-back"
+		}
+		log.Printf("INFO: Geocodificación inversa con Nominatim en %s", baseURL)
+		geocoder = services_projects.NewNominatimGeocoder(baseURL, userAgent, time.Second)
+	case "offline":
+		path := os.Getenv("GEOCODER_BOUNDARIES_FILE")
+		if path == "" {
+			log.Println("ERROR: GEOCODER=offline requiere GEOCODER_BOUNDARIES_FILE; geocodificación desactivada")
+			return nil
+		}
+		offline, err := services_projects.NewOfflineGeocoder(path)
+		if err != nil {
+			log.Printf("ERROR: Geocodificación desactivada: %v", err)
+			return nil
+		}
+		log.Printf("INFO: Geocodificación inversa sin conexión con %s", path)
+		geocoder = offline
+	default:
+		log.Printf("ERROR: GEOCODER=%q no es válido; geocodificación desactivada", os.Getenv("GEOCODER"))
+		return nil
+	}
+
+	ttl, err := time.ParseDuration(os.Getenv("GEOCODER_CACHE_TTL"))
+	if err != nil || ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+	return services_projects.NewCachedGeocoder(geocoder, ttl, 10000)
+}
+
+// newStatusWorkflow lee el flujo de estados de PROJECT_STATUS_TRANSITIONS
+// ("draft:planned,archived;planned:in_field,draft;..."); sin la variable se usa el flujo por defecto
+func newStatusWorkflow() entities.ProjectStatusWorkflow {
+	spec := os.Getenv("PROJECT_STATUS_TRANSITIONS")
+	if spec == "" {
+		return domain_services.DefaultProjectStatusWorkflow()
+	}
+	workflow, err := domain_services.ParseProjectStatusWorkflow(spec)
+	if err != nil {
+		panic("PROJECT_STATUS_TRANSITIONS inválido: " + err.Error())
+	}
+	log.Println("INFO: Flujo de estados de proyecto configurado desde PROJECT_STATUS_TRANSITIONS")
+	return workflow
+}
+
+// documentMaxSize lee DOCUMENTS_MAX_SIZE_MB; 0 usa el tamaño máximo por defecto
+func documentMaxSize() int64 {
+	mb, err := strconv.Atoi(os.Getenv("DOCUMENTS_MAX_SIZE_MB"))
+	if err != nil || mb <= 0 {
+		return 0
+	}
+	return int64(mb) << 20
+}
+
 // Shutdown cierra todas las conexiones de forma limpia
 func (pi *ProjectInfrastructure) Shutdown() {
 	log.Println("INFO: Cerrando infraestructura de proyectos...")
@@ -122,6 +441,14 @@ func (pi *ProjectInfrastructure) Shutdown() {
 		pi.WorkerSrv.Shutdown()
 		log.Println("INFO: Worker service cerrado")
 	}
+	if pi.GeocodeSrv != nil {
+		pi.GeocodeSrv.Shutdown()
+		log.Println("INFO: Servicio de geocodificación cerrado")
+	}
+	if pi.ImportSrv != nil {
+		pi.ImportSrv.Shutdown()
+		log.Println("INFO: Servicio de importación cerrado")
+	}
 
 	if pi.DB != nil && pi.DB.DB != nil {
 		pi.DB.DB.Close()
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/core"
+)
+
+type CategoryMySQLRepository struct {
+	db *core.Conn_MySQL
+}
+
+func NewCategoryMySQLRepository(db *core.Conn_MySQL) repository.CategoryRepository {
+	return &CategoryMySQLRepository{db: db}
+}
+
+const categorySelectColumns = `Id, slug, nombre, color, icon, created_at, updated_at`
+
+func (r *CategoryMySQLRepository) Save(category entities.Category) (int, error) {
+	now := time.Now().UTC()
+	query := `INSERT INTO categories (slug, nombre, color, icon, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`
+	result, err := r.db.ExecutePreparedQuery(query, category.Slug, category.Nombre, category.Color, category.Icon, now, now)
+	if err != nil {
+		return 0, fmt.Errorf("error al guardar categoría: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("error al obtener el ID de la categoría: %w", err)
+	}
+	return int(id), nil
+}
+
+func (r *CategoryMySQLRepository) Update(category entities.Category) error {
+	tx, err := r.db.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error al iniciar la transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE categories SET slug = ?, nombre = ?, color = ?, icon = ?, updated_at = ? WHERE Id = ?`
+	if _, err := tx.Exec(query, category.Slug, category.Nombre, category.Color, category.Icon, time.Now().UTC(), category.Id); err != nil {
+		return fmt.Errorf("error al actualizar categoría: %w", err)
+	}
+	// Los proyectos guardan una copia del nombre para no romper a los clientes que leen Categoria
+	if _, err := tx.Exec(`UPDATE projects SET Categoria = ? WHERE category_id = ?`, category.Nombre, category.Id); err != nil {
+		return fmt.Errorf("error al actualizar la categoría de los proyectos: %w", err)
+	}
+	return tx.Commit()
+}
+
+func (r *CategoryMySQLRepository) Delete(id int) error {
+	if _, err := r.db.ExecutePreparedQuery(`DELETE FROM categories WHERE Id = ?`, id); err != nil {
+		return fmt.Errorf("error al eliminar categoría: %w", err)
+	}
+	return nil
+}
+
+func (r *CategoryMySQLRepository) FindById(id int) (*entities.Category, error) {
+	return r.findOne(`SELECT `+categorySelectColumns+` FROM categories WHERE Id = ?`, id)
+}
+
+func (r *CategoryMySQLRepository) FindBySlug(slug string) (*entities.Category, error) {
+	return r.findOne(`SELECT `+categorySelectColumns+` FROM categories WHERE slug = ?`, slug)
+}
+
+func (r *CategoryMySQLRepository) findOne(query string, args ...interface{}) (*entities.Category, error) {
+	var category entities.Category
+	err := r.db.DB.QueryRow(query, args...).Scan(&category.Id, &category.Slug, &category.Nombre, &category.Color, &category.Icon, &category.CreatedAt, &category.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("categoría %w", entities.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar categoría: %w", err)
+	}
+	return &category, nil
+}
+
+func (r *CategoryMySQLRepository) FindAll() ([]entities.Category, error) {
+	rows, err := r.db.DB.Query(`SELECT ` + categorySelectColumns + ` FROM categories ORDER BY nombre`)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar categorías: %w", err)
+	}
+	defer rows.Close()
+
+	categories := make([]entities.Category, 0)
+	for rows.Next() {
+		var category entities.Category
+		if err := rows.Scan(&category.Id, &category.Slug, &category.Nombre, &category.Color, &category.Icon, &category.CreatedAt, &category.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("error al escanear categoría: %w", err)
+		}
+		categories = append(categories, category)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar categorías: %w", err)
+	}
+	return categories, nil
+}
+
+func (r *CategoryMySQLRepository) CountProjects(id int) (int, error) {
+	var count int
+	if err := r.db.DB.QueryRow(`SELECT COUNT(*) FROM projects WHERE category_id = ?`, id).Scan(&count); err != nil {
+		return 0, fmt.Errorf("error al contar proyectos de la categoría: %w", err)
+	}
+	return count, nil
+}
+
+func (r *CategoryMySQLRepository) FindOverrides(orgId int) ([]entities.CategoryOverride, error) {
+	query := `SELECT category_id, org_id, nombre, color, icon, hidden FROM category_overrides WHERE org_id = ?`
+	rows, err := r.db.DB.Query(query, orgId)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar personalizaciones: %w", err)
+	}
+	defer rows.Close()
+
+	overrides := make([]entities.CategoryOverride, 0)
+	for rows.Next() {
+		var override entities.CategoryOverride
+		if err := rows.Scan(&override.CategoryId, &override.OrgId, &override.Nombre, &override.Color, &override.Icon, &override.Hidden); err != nil {
+			return nil, fmt.Errorf("error al escanear personalización: %w", err)
+		}
+		overrides = append(overrides, override)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar personalizaciones: %w", err)
+	}
+	return overrides, nil
+}
+
+func (r *CategoryMySQLRepository) SaveOverride(override entities.CategoryOverride) error {
+	query := `INSERT INTO category_overrides (category_id, org_id, nombre, color, icon, hidden) VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE nombre = VALUES(nombre), color = VALUES(color), icon = VALUES(icon), hidden = VALUES(hidden)`
+	_, err := r.db.ExecutePreparedQuery(query, override.CategoryId, override.OrgId, override.Nombre, override.Color, override.Icon, override.Hidden)
+	if err != nil {
+		return fmt.Errorf("error al guardar personalización: %w", err)
+	}
+	return nil
+}
+
+func (r *CategoryMySQLRepository) DeleteOverride(categoryId, orgId int) error {
+	result, err := r.db.ExecutePreparedQuery(`DELETE FROM category_overrides WHERE category_id = ? AND org_id = ?`, categoryId, orgId)
+	if err != nil {
+		return fmt.Errorf("error al eliminar personalización: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return fmt.Errorf("personalización %w", entities.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/core"
+)
+
+// measurementInsertChunk es la cantidad de filas por INSERT al guardar un lote
+const measurementInsertChunk = 500
+
+type MeasurementMySQLRepository struct {
+	db *core.Conn_MySQL
+}
+
+func NewMeasurementMySQLRepository(db *core.Conn_MySQL) repository.MeasurementRepository {
+	return &MeasurementMySQLRepository{db: db}
+}
+
+const measurementSelectColumns = `Id, project_id, lat, lng, elevation, measured_at, instrument, accuracy, notes, created_at, updated_at`
+
+func scanMeasurement(scan func(dest ...interface{}) error) (entities.Measurement, error) {
+	var m entities.Measurement
+	var elevation, accuracy sql.NullFloat64
+	err := scan(&m.Id, &m.ProjectId, &m.Lat, &m.Lng, &elevation, &m.MeasuredAt, &m.Instrument, &accuracy, &m.Notes, &m.CreatedAt, &m.UpdatedAt)
+	if elevation.Valid {
+		m.Elevation = &elevation.Float64
+	}
+	if accuracy.Valid {
+		m.Accuracy = &accuracy.Float64
+	}
+	return m, err
+}
+
+func nullableFloat(value *float64) sql.NullFloat64 {
+	if value == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *value, Valid: true}
+}
+
+func (r *MeasurementMySQLRepository) SaveBatch(projectId int, measurements []entities.Measurement) error {
+	tx, err := r.db.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error al iniciar la transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	for start := 0; start < len(measurements); start += measurementInsertChunk {
+		chunk := measurements[start:min(start+measurementInsertChunk, len(measurements))]
+		placeholders := make([]string, 0, len(chunk))
+		args := make([]interface{}, 0, len(chunk)*10)
+		for _, m := range chunk {
+			placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+			args = append(args, projectId, m.Lat, m.Lng, nullableFloat(m.Elevation), m.MeasuredAt.UTC(), m.Instrument, nullableFloat(m.Accuracy), m.Notes, now, now)
+		}
+		query := `INSERT INTO measurements (project_id, lat, lng, elevation, measured_at, instrument, accuracy, notes, created_at, updated_at)
+			VALUES ` + strings.Join(placeholders, ", ")
+		if _, err := tx.Exec(query, args...); err != nil {
+			return fmt.Errorf("error al guardar puntos: %w", err)
+		}
+	}
+
+	if err := refreshPointCount(tx, projectId); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *MeasurementMySQLRepository) FindByProject(projectId, afterId, limit int) ([]entities.Measurement, error) {
+	query := `SELECT ` + measurementSelectColumns + ` FROM measurements WHERE project_id = ? AND Id > ? ORDER BY Id LIMIT ?`
+	rows, err := r.db.DB.Query(query, projectId, afterId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar puntos: %w", err)
+	}
+	defer rows.Close()
+
+	measurements := make([]entities.Measurement, 0)
+	for rows.Next() {
+		m, err := scanMeasurement(rows.Scan)
+		if err != nil {
+			return nil, fmt.Errorf("error al escanear punto: %w", err)
+		}
+		measurements = append(measurements, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar puntos: %w", err)
+	}
+	return measurements, nil
+}
+
+func (r *MeasurementMySQLRepository) FindById(projectId, measurementId int) (*entities.Measurement, error) {
+	query := `SELECT ` + measurementSelectColumns + ` FROM measurements WHERE Id = ? AND project_id = ?`
+	m, err := scanMeasurement(r.db.DB.QueryRow(query, measurementId, projectId).Scan)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("punto %w", entities.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar punto: %w", err)
+	}
+	return &m, nil
+}
+
+func (r *MeasurementMySQLRepository) Update(m entities.Measurement) error {
+	query := `UPDATE measurements SET lat = ?, lng = ?, elevation = ?, measured_at = ?, instrument = ?, accuracy = ?, notes = ?, updated_at = ?
+		WHERE Id = ? AND project_id = ?`
+	result, err := r.db.ExecutePreparedQuery(query, m.Lat, m.Lng, nullableFloat(m.Elevation), m.MeasuredAt.UTC(), m.Instrument,
+		nullableFloat(m.Accuracy), m.Notes, time.Now().UTC(), m.Id, m.ProjectId)
+	if err != nil {
+		return fmt.Errorf("error al actualizar punto: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return fmt.Errorf("punto %w", entities.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *MeasurementMySQLRepository) Delete(projectId, measurementId int) error {
+	tx, err := r.db.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error al iniciar la transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`DELETE FROM measurements WHERE Id = ? AND project_id = ?`, measurementId, projectId)
+	if err != nil {
+		return fmt.Errorf("error al eliminar punto: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return fmt.Errorf("punto %w", entities.ErrNotFound)
+	}
+	if err := refreshPointCount(tx, projectId); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// refreshPointCount recalcula projects.point_count, que se guarda para no contar en cada listado
+func refreshPointCount(tx *sql.Tx, projectId int) error {
+	query := `UPDATE projects SET point_count = (SELECT COUNT(*) FROM measurements WHERE project_id = ?) WHERE Id = ?`
+	if _, err := tx.Exec(query, projectId, projectId); err != nil {
+		return fmt.Errorf("error al actualizar el conteo de puntos: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/core"
+)
+
+type ProjectBulkMySQLRepository struct {
+	db *core.Conn_MySQL
+}
+
+func NewProjectBulkMySQLRepository(db *core.Conn_MySQL) repository.ProjectBulkRepository {
+	return &ProjectBulkMySQLRepository{db: db}
+}
+
+// inTransaction ejecuta fn en una transacción y la confirma solo si fn no devuelve error
+func inTransaction(db *core.Conn_MySQL, fn func(tx *sql.Tx) error) error {
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error al iniciar la transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// execVersioned ejecuta una sentencia que debe afectar exactamente al proyecto de target
+func execVersioned(tx *sql.Tx, target entities.BulkTarget, query string, args ...interface{}) error {
+	result, err := tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("error al modificar el proyecto %d: %w", target.Id, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error al modificar el proyecto %d: %w", target.Id, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%w: el proyecto %d ya no está en la versión %d", entities.ErrVersionConflict, target.Id, target.Version)
+	}
+	return nil
+}
+
+func (r *ProjectBulkMySQLRepository) DeleteProjects(targets []entities.BulkTarget) error {
+	return inTransaction(r.db, func(tx *sql.Tx) error {
+		for _, target := range targets {
+			if err := execVersioned(tx, target, `DELETE FROM projects WHERE Id = ? AND version = ?`, target.Id, target.Version); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *ProjectBulkMySQLRepository) UpdateCategory(targets []entities.BulkTarget, categoryId int, categoria string, revision entities.ProjectRevision) error {
+	now := time.Now().UTC()
+	return inTransaction(r.db, func(tx *sql.Tx) error {
+		for _, target := range targets {
+			err := execRevisioned(tx, target, revision, `UPDATE projects SET Categoria = ?, category_id = ?, updated_at = ?, version = version + 1 WHERE Id = ? AND version = ?`,
+				categoria, categoryId, now, target.Id, target.Version)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *ProjectBulkMySQLRepository) UpdateOwner(targets []entities.BulkTarget, userId int, revision entities.ProjectRevision) error {
+	now := time.Now().UTC()
+	return inTransaction(r.db, func(tx *sql.Tx) error {
+		// Se bloquea el usuario para que no se elimine antes de confirmar la reasignación
+		var exists int
+		err := tx.QueryRow(`SELECT 1 FROM users WHERE Id = ? FOR SHARE`, userId).Scan(&exists)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: el usuario %d no existe", entities.ErrInvalidInput, userId)
+		}
+		if err != nil {
+			return fmt.Errorf("error al consultar el usuario: %w", err)
+		}
+
+		for _, target := range targets {
+			err := execRevisioned(tx, target, revision, `UPDATE projects SET user_id = ?, updated_at = ?, version = version + 1 WHERE Id = ? AND version = ?`,
+				userId, now, target.Id, target.Version)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *ProjectBulkMySQLRepository) ChangeTags(projectIds []int, add []entities.Tag, remove []string) error {
+	now := time.Now().UTC()
+	return inTransaction(r.db, func(tx *sql.Tx) error {
+		tagIds := make([]int64, 0, len(add))
+		for _, tag := range add {
+			// LAST_INSERT_ID(Id) hace que LastInsertId devuelva el Id de la etiqueta existente
+			result, err := tx.Exec(`INSERT INTO tags (slug, nombre, created_at) VALUES (?, ?, ?)
+				ON DUPLICATE KEY UPDATE Id = LAST_INSERT_ID(Id)`, tag.Slug, tag.Nombre, now)
+			if err != nil {
+				return fmt.Errorf("error al guardar la etiqueta %q: %w", tag.Nombre, err)
+			}
+			tagId, err := result.LastInsertId()
+			if err != nil {
+				return fmt.Errorf("error al obtener el ID de la etiqueta: %w", err)
+			}
+			tagIds = append(tagIds, tagId)
+		}
+
+		for _, projectId := range projectIds {
+			for _, tagId := range tagIds {
+				if _, err := tx.Exec(`INSERT IGNORE INTO project_tags (project_id, tag_id, created_at) VALUES (?, ?, ?)`, projectId, tagId, now); err != nil {
+					return fmt.Errorf("error al asignar etiquetas al proyecto %d: %w", projectId, err)
+				}
+			}
+			for _, slug := range remove {
+				_, err := tx.Exec(`DELETE pt FROM project_tags pt
+					JOIN tags t ON t.Id = pt.tag_id
+					WHERE pt.project_id = ? AND t.slug = ?`, projectId, slug)
+				if err != nil {
+					return fmt.Errorf("error al quitar etiquetas del proyecto %d: %w", projectId, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (r *ProjectBulkMySQLRepository) ChangeStatuses(targets []entities.BulkTarget, transitions []entities.ProjectStatusTransition) error {
+	if len(targets) != len(transitions) {
+		return fmt.Errorf("se esperaba una transición por proyecto")
+	}
+	now := time.Now().UTC()
+	return inTransaction(r.db, func(tx *sql.Tx) error {
+		for i, target := range targets {
+			transition := transitions[i]
+			// El estado de origen también condiciona el UPDATE, igual que en una transición individual
+			err := execVersioned(tx, target, `UPDATE projects SET status = ?, updated_at = ?, version = version + 1 WHERE Id = ? AND version = ? AND status = ?`,
+				transition.ToStatus, now, target.Id, target.Version, transition.FromStatus)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(`INSERT INTO project_status_transitions (project_id, from_status, to_status, comment, user_id, created_at) VALUES (?, ?, ?, ?, ?, ?)`,
+				target.Id, transition.FromStatus, transition.ToStatus, transition.Comment, nullableInt(transition.UserId), now)
+			if err != nil {
+				return fmt.Errorf("error al guardar la transición de estado del proyecto %d: %w", target.Id, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/core"
+)
+
+type ProjectDocumentMySQLRepository struct {
+	db *core.Conn_MySQL
+}
+
+func NewProjectDocumentMySQLRepository(db *core.Conn_MySQL) repository.ProjectDocumentRepository {
+	return &ProjectDocumentMySQLRepository{db: db}
+}
+
+const documentSelectColumns = `Id, project_id, file_name, content_type, size, checksum, location, uploaded_by, created_at`
+
+func scanDocument(scan func(dest ...interface{}) error) (entities.ProjectDocument, error) {
+	var document entities.ProjectDocument
+	var uploadedBy sql.NullInt64
+	err := scan(&document.Id, &document.ProjectId, &document.FileName, &document.ContentType, &document.Size,
+		&document.Checksum, &document.Location, &uploadedBy, &document.CreatedAt)
+	document.UploadedBy = int(uploadedBy.Int64)
+	return document, err
+}
+
+func (r *ProjectDocumentMySQLRepository) Save(document entities.ProjectDocument) (int, error) {
+	query := `INSERT INTO project_documents (project_id, file_name, content_type, size, checksum, location, uploaded_by, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := r.db.ExecutePreparedQuery(query, document.ProjectId, document.FileName, document.ContentType, document.Size,
+		document.Checksum, document.Location, nullableInt(document.UploadedBy), time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("error al guardar documento: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("error al obtener el ID del documento: %w", err)
+	}
+	return int(id), nil
+}
+
+func (r *ProjectDocumentMySQLRepository) FindByProject(projectId int) ([]entities.ProjectDocument, error) {
+	query := `SELECT ` + documentSelectColumns + ` FROM project_documents WHERE project_id = ? ORDER BY created_at DESC, Id DESC`
+	rows, err := r.db.DB.Query(query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar documentos: %w", err)
+	}
+	defer rows.Close()
+
+	documents := make([]entities.ProjectDocument, 0)
+	for rows.Next() {
+		document, err := scanDocument(rows.Scan)
+		if err != nil {
+			return nil, fmt.Errorf("error al escanear documento: %w", err)
+		}
+		documents = append(documents, document)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar documentos: %w", err)
+	}
+	return documents, nil
+}
+
+func (r *ProjectDocumentMySQLRepository) FindById(projectId, documentId int) (*entities.ProjectDocument, error) {
+	query := `SELECT ` + documentSelectColumns + ` FROM project_documents WHERE Id = ? AND project_id = ?`
+	return r.findOne(query, documentId, projectId)
+}
+
+func (r *ProjectDocumentMySQLRepository) FindByChecksum(projectId int, checksum string) (*entities.ProjectDocument, error) {
+	query := `SELECT ` + documentSelectColumns + ` FROM project_documents WHERE project_id = ? AND checksum = ? LIMIT 1`
+	return r.findOne(query, projectId, checksum)
+}
+
+func (r *ProjectDocumentMySQLRepository) findOne(query string, args ...interface{}) (*entities.ProjectDocument, error) {
+	document, err := scanDocument(r.db.DB.QueryRow(query, args...).Scan)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("documento %w", entities.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar documento: %w", err)
+	}
+	return &document, nil
+}
+
+func (r *ProjectDocumentMySQLRepository) Delete(projectId, documentId int) error {
+	result, err := r.db.ExecutePreparedQuery(`DELETE FROM project_documents WHERE Id = ? AND project_id = ?`, documentId, projectId)
+	if err != nil {
+		return fmt.Errorf("error al eliminar documento: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return fmt.Errorf("documento %w", entities.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/core"
+)
+
+type ProjectMediaMySQLRepository struct {
+	db *core.Conn_MySQL
+}
+
+func NewProjectMediaMySQLRepository(db *core.Conn_MySQL) repository.ProjectMediaRepository {
+	return &ProjectMediaMySQLRepository{db: db}
+}
+
+const mediaSelectColumns = `Id, project_id, url, caption, position, is_cover, captured_at, lat, lng, created_at`
+
+func (r *ProjectMediaMySQLRepository) FindByProject(projectId int) ([]entities.ProjectMedia, error) {
+	query := `SELECT ` + mediaSelectColumns + ` FROM project_media WHERE project_id = ? ORDER BY position, Id`
+	rows, err := r.db.DB.Query(query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar la galería: %w", err)
+	}
+	defer rows.Close()
+
+	media := make([]entities.ProjectMedia, 0)
+	for rows.Next() {
+		var item entities.ProjectMedia
+		var capturedAt sql.NullTime
+		var lat, lng sql.NullFloat64
+		if err := rows.Scan(&item.Id, &item.ProjectId, &item.Url, &item.Caption, &item.Position, &item.IsCover, &capturedAt, &lat, &lng, &item.CreatedAt); err != nil {
+			return nil, fmt.Errorf("error al escanear imagen: %w", err)
+		}
+		if capturedAt.Valid {
+			item.CapturedAt = &capturedAt.Time
+		}
+		if lat.Valid && lng.Valid {
+			item.Lat, item.Lng = &lat.Float64, &lng.Float64
+		}
+		media = append(media, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar la galería: %w", err)
+	}
+	return media, nil
+}
+
+func (r *ProjectMediaMySQLRepository) Add(projectId int, media []entities.ProjectMedia, editorId int) error {
+	tx, err := r.db.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error al iniciar la transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	var next int
+	if err := tx.QueryRow(`SELECT COALESCE(MAX(position), -1) + 1 FROM project_media WHERE project_id = ? FOR UPDATE`, projectId).Scan(&next); err != nil {
+		return fmt.Errorf("error al consultar la galería: %w", err)
+	}
+
+	now := time.Now().UTC()
+	for i, item := range media {
+		if item.IsCover {
+			if _, err := tx.Exec(`UPDATE project_media SET is_cover = FALSE WHERE project_id = ?`, projectId); err != nil {
+				return fmt.Errorf("error al cambiar la portada: %w", err)
+			}
+		}
+		var capturedAt sql.NullTime
+		if item.CapturedAt != nil {
+			capturedAt = sql.NullTime{Time: item.CapturedAt.UTC(), Valid: true}
+		}
+		var lat, lng sql.NullFloat64
+		if item.Lat != nil && item.Lng != nil {
+			lat = sql.NullFloat64{Float64: *item.Lat, Valid: true}
+			lng = sql.NullFloat64{Float64: *item.Lng, Valid: true}
+		}
+		query := `INSERT INTO project_media (project_id, url, caption, position, is_cover, captured_at, lat, lng, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		if _, err := tx.Exec(query, projectId, item.Url, item.Caption, next+i, item.IsCover, capturedAt, lat, lng, now); err != nil {
+			return fmt.Errorf("error al guardar imagen: %w", err)
+		}
+	}
+
+	if err := syncProjectCover(tx, projectId, editorId); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *ProjectMediaMySQLRepository) Reorder(projectId int, mediaIds []int) error {
+	tx, err := r.db.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error al iniciar la transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	for position, id := range mediaIds {
+		if _, err := tx.Exec(`UPDATE project_media SET position = ? WHERE Id = ? AND project_id = ?`, position, id, projectId); err != nil {
+			return fmt.Errorf("error al ordenar la galería: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *ProjectMediaMySQLRepository) SetCover(projectId, mediaId, editorId int) error {
+	tx, err := r.db.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error al iniciar la transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRow(`SELECT TRUE FROM project_media WHERE Id = ? AND project_id = ?`, mediaId, projectId).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("imagen %w", entities.ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("error al consultar imagen: %w", err)
+	}
+
+	if _, err := tx.Exec(`UPDATE project_media SET is_cover = (Id = ?) WHERE project_id = ?`, mediaId, projectId); err != nil {
+		return fmt.Errorf("error al cambiar la portada: %w", err)
+	}
+	if err := syncProjectCover(tx, projectId, editorId); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *ProjectMediaMySQLRepository) Delete(projectId, mediaId, editorId int) error {
+	tx, err := r.db.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error al iniciar la transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`DELETE FROM project_media WHERE Id = ? AND project_id = ?`, mediaId, projectId)
+	if err != nil {
+		return fmt.Errorf("error al eliminar imagen: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return fmt.Errorf("imagen %w", entities.ErrNotFound)
+	}
+	if err := syncProjectCover(tx, projectId, editorId); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// syncProjectCover garantiza que una galería con imágenes tenga portada (la primera si se eliminó
+// la anterior) y copia su URL a projects.Img; sin imágenes Img queda vacío. Si Img cambia, el proyecto
+// incrementa su versión y guarda la revisión del cambio como cualquier otra edición
+func syncProjectCover(tx *sql.Tx, projectId, editorId int) error {
+	var covers int
+	if err := tx.QueryRow(`SELECT COUNT(*) FROM project_media WHERE project_id = ? AND is_cover`, projectId).Scan(&covers); err != nil {
+		return fmt.Errorf("error al consultar la portada: %w", err)
+	}
+	if covers == 0 {
+		query := `UPDATE project_media SET is_cover = TRUE WHERE project_id = ? ORDER BY position, Id LIMIT 1`
+		if _, err := tx.Exec(query, projectId); err != nil {
+			return fmt.Errorf("error al asignar la portada: %w", err)
+		}
+	}
+
+	var cover string
+	query := `SELECT COALESCE((SELECT url FROM project_media WHERE project_id = ? AND is_cover LIMIT 1), '')`
+	if err := tx.QueryRow(query, projectId).Scan(&cover); err != nil {
+		return fmt.Errorf("error al consultar la portada: %w", err)
+	}
+	project, err := findProjectTx(tx, projectId, true)
+	if err != nil {
+		return err
+	}
+	if project.Img == cover {
+		return nil
+	}
+
+	target := entities.BulkTarget{Id: projectId, Version: project.Version}
+	change := entities.ProjectRevision{Action: entities.RevisionActionUpdate, EditorId: editorId}
+	return execRevisioned(tx, target, change, `UPDATE projects SET Img = ?, updated_at = ?, version = version + 1 WHERE Id = ? AND version = ?`,
+		cover, time.Now().UTC(), projectId, project.Version)
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/core"
+)
+
+type ProjectStatusMySQLRepository struct {
+	db *core.Conn_MySQL
+}
+
+func NewProjectStatusMySQLRepository(db *core.Conn_MySQL) repository.ProjectStatusRepository {
+	return &ProjectStatusMySQLRepository{db: db}
+}
+
+func (r *ProjectStatusMySQLRepository) ChangeStatus(transition entities.ProjectStatusTransition, version int) error {
+	tx, err := r.db.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error al iniciar la transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	// El estado de origen también condiciona el UPDATE para que dos transiciones simultáneas no se pisen
+	result, err := tx.Exec(`UPDATE projects SET status = ?, updated_at = ?, version = version + 1 WHERE Id = ? AND version = ? AND status = ?`,
+		transition.ToStatus, now, transition.ProjectId, version, transition.FromStatus)
+	if err != nil {
+		return fmt.Errorf("error al cambiar el estado del proyecto: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error al cambiar el estado del proyecto: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%w: el proyecto %d ya no está en la versión %d", entities.ErrVersionConflict, transition.ProjectId, version)
+	}
+
+	_, err = tx.Exec(`INSERT INTO project_status_transitions (project_id, from_status, to_status, comment, user_id, created_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		transition.ProjectId, transition.FromStatus, transition.ToStatus, transition.Comment, nullableInt(transition.UserId), now)
+	if err != nil {
+		return fmt.Errorf("error al guardar la transición de estado: %w", err)
+	}
+	return tx.Commit()
+}
+
+func (r *ProjectStatusMySQLRepository) FindTransitions(projectId int) ([]entities.ProjectStatusTransition, error) {
+	query := `SELECT Id, project_id, from_status, to_status, comment, COALESCE(user_id, 0), created_at
+		FROM project_status_transitions WHERE project_id = ? ORDER BY Id DESC`
+	rows, err := r.db.DB.Query(query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar transiciones de estado: %w", err)
+	}
+	defer rows.Close()
+
+	transitions := make([]entities.ProjectStatusTransition, 0)
+	for rows.Next() {
+		var t entities.ProjectStatusTransition
+		if err := rows.Scan(&t.Id, &t.ProjectId, &t.FromStatus, &t.ToStatus, &t.Comment, &t.UserId, &t.CreatedAt); err != nil {
+			return nil, fmt.Errorf("error al escanear transición de estado: %w", err)
+		}
+		transitions = append(transitions, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar transiciones de estado: %w", err)
+	}
+	return transitions, nil
+}
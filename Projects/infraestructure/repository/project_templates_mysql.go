@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/core"
+)
+
+type ProjectTemplateMySQLRepository struct {
+	db *core.Conn_MySQL
+}
+
+func NewProjectTemplateMySQLRepository(db *core.Conn_MySQL) repository.ProjectTemplateRepository {
+	return &ProjectTemplateMySQLRepository{db: db}
+}
+
+// El nombre de la categoría se toma del catálogo para que siga al día si se renombra
+const templateSelectQuery = `SELECT t.Id, t.name, t.category_id, c.nombre, t.descripcion, t.checklist, COALESCE(t.user_id, 0), t.created_at, t.updated_at
+	FROM project_templates t JOIN categories c ON c.Id = t.category_id`
+
+func scanTemplate(scan func(dest ...interface{}) error) (entities.ProjectTemplate, error) {
+	var template entities.ProjectTemplate
+	var checklist string
+	if err := scan(&template.Id, &template.Name, &template.CategoryId, &template.Categoria, &template.Descripcion, &checklist, &template.UserId, &template.CreatedAt, &template.UpdatedAt); err != nil {
+		return template, err
+	}
+	if err := json.Unmarshal([]byte(checklist), &template.Checklist); err != nil {
+		return template, fmt.Errorf("lista de verificación guardada inválida en la plantilla %d: %w", template.Id, err)
+	}
+	return template, nil
+}
+
+func templateChecklistValue(checklist []string) (string, error) {
+	if checklist == nil {
+		checklist = []string{}
+	}
+	raw, err := json.Marshal(checklist)
+	if err != nil {
+		return "", fmt.Errorf("error al serializar la lista de verificación: %w", err)
+	}
+	return string(raw), nil
+}
+
+func (r *ProjectTemplateMySQLRepository) Save(template entities.ProjectTemplate) (int, error) {
+	checklist, err := templateChecklistValue(template.Checklist)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now().UTC()
+	query := `INSERT INTO project_templates (name, category_id, descripcion, checklist, user_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	result, err := r.db.ExecutePreparedQuery(query, template.Name, template.CategoryId, template.Descripcion, checklist, nullableInt(template.UserId), now, now)
+	if err != nil {
+		return 0, fmt.Errorf("error al guardar plantilla: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("error al obtener el ID de la plantilla: %w", err)
+	}
+	return int(id), nil
+}
+
+func (r *ProjectTemplateMySQLRepository) FindAll() ([]entities.ProjectTemplate, error) {
+	rows, err := r.db.DB.Query(templateSelectQuery + ` ORDER BY t.name`)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar plantillas: %w", err)
+	}
+	defer rows.Close()
+
+	templates := make([]entities.ProjectTemplate, 0)
+	for rows.Next() {
+		template, err := scanTemplate(rows.Scan)
+		if err != nil {
+			return nil, fmt.Errorf("error al escanear plantilla: %w", err)
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar plantillas: %w", err)
+	}
+	return templates, nil
+}
+
+func (r *ProjectTemplateMySQLRepository) FindById(id int) (*entities.ProjectTemplate, error) {
+	template, err := scanTemplate(r.db.DB.QueryRow(templateSelectQuery+` WHERE t.Id = ?`, id).Scan)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("plantilla %w", entities.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar plantilla: %w", err)
+	}
+	return &template, nil
+}
+
+func (r *ProjectTemplateMySQLRepository) Update(template entities.ProjectTemplate) error {
+	checklist, err := templateChecklistValue(template.Checklist)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE project_templates SET name = ?, category_id = ?, descripcion = ?, checklist = ?, updated_at = ? WHERE Id = ?`
+	if _, err := r.db.ExecutePreparedQuery(query, template.Name, template.CategoryId, template.Descripcion, checklist, time.Now().UTC(), template.Id); err != nil {
+		return fmt.Errorf("error al actualizar plantilla: %w", err)
+	}
+	return nil
+}
+
+func (r *ProjectTemplateMySQLRepository) Delete(id int) error {
+	if _, err := r.db.ExecutePreparedQuery(`DELETE FROM project_templates WHERE Id = ?`, id); err != nil {
+		return fmt.Errorf("error al eliminar plantilla: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+const projectSelectColumns = `Id, NombreProyecto, Fecha, Categoria, Descripcion, Img, Lat, Lng, user_id, created_at, updated_at, version, category_id, point_count, geometry, area_m2, perimeter_m, municipality, state, country, country_code, geocoded_at, status, checklist, COALESCE(template_id, 0)`
+
+// cursorTimeLayout es el formato de las fechas guardadas en el cursor, comparable con DATETIME
+const cursorTimeLayout = "2006-01-02 15:04:05.999999"
+
+// sortColumns traduce los campos de ordenamiento públicos a columnas de la tabla
+var sortColumns = map[string]string{
+	entities.SortById:        "Id",
+	entities.SortByNombre:    "NombreProyecto",
+	entities.SortByFecha:     "Fecha",
+	entities.SortByCategoria: "Categoria",
+}
+
+// projectCursor es el contenido del cursor opaco de paginación
+type projectCursor struct {
+	Value string `json:"v"`
+	Id    int    `json:"id"`
+}
+
+func encodeProjectCursor(cursor projectCursor) string {
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeProjectCursor(token string) (*projectCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cursor malformado", entities.ErrInvalidFilter)
+	}
+	var cursor projectCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, fmt.Errorf("%w: cursor malformado", entities.ErrInvalidFilter)
+	}
+	return &cursor, nil
+}
+
+// sortValue obtiene el valor del campo de ordenamiento de un proyecto para construir el cursor
+func sortValue(project entities.Project, field string) string {
+	switch field {
+	case entities.SortByNombre:
+		return project.NombreProyecto
+	case entities.SortByFecha:
+		return project.Fecha.UTC().Format(cursorTimeLayout)
+	case entities.SortByCategoria:
+		return project.Categoria
+	default:
+		return fmt.Sprint(project.Id)
+	}
+}
+
+// escapeLike escapa los comodines de LIKE para buscar el texto de forma literal
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(value)
+}
+
+// buildProjectFilterWhere arma la cláusula WHERE de los filtros (sin cursor) y sus argumentos
+func buildProjectFilterWhere(filter entities.ProjectFilter) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	if filter.Categoria != "" {
+		// Se filtra por slug, de modo que "Topografía" y "topografia" son la misma categoría
+		conditions = append(conditions, "category_id = (SELECT Id FROM categories WHERE slug = ?)")
+		args = append(args, services.Slugify(filter.Categoria))
+	}
+	if filter.UserId > 0 {
+		conditions = append(conditions, "user_id = ?")
+		args = append(args, filter.UserId)
+	}
+	if !filter.FechaDesde.IsZero() {
+		conditions = append(conditions, "Fecha >= ?")
+		args = append(args, filter.FechaDesde.UTC())
+	}
+	if !filter.FechaHasta.IsZero() {
+		conditions = append(conditions, "Fecha < ?")
+		args = append(args, filter.FechaHasta.UTC())
+	}
+	if filter.Nombre != "" {
+		conditions = append(conditions, "NombreProyecto LIKE ?")
+		args = append(args, "%"+escapeLike(filter.Nombre)+"%")
+	}
+	if len(filter.Statuses) > 0 {
+		conditions = append(conditions, "status IN ("+strings.TrimSuffix(strings.Repeat("?, ", len(filter.Statuses)), ", ")+")")
+		for _, status := range filter.Statuses {
+			args = append(args, status)
+		}
+	}
+	if filter.Municipality != "" {
+		conditions = append(conditions, "municipality = ?")
+		args = append(args, filter.Municipality)
+	}
+	if filter.State != "" {
+		conditions = append(conditions, "state = ?")
+		args = append(args, filter.State)
+	}
+	if filter.Country != "" {
+		conditions = append(conditions, "(country = ? OR country_code = ?)")
+		args = append(args, filter.Country, filter.Country)
+	}
+	if len(filter.Tags) > 0 {
+		condition, tagArgs := tagsCondition(filter.Tags, filter.TagMatch)
+		conditions = append(conditions, condition)
+		args = append(args, tagArgs...)
+	}
+	if filter.BBox != nil {
+		condition, bboxArgs := bboxCondition(*filter.BBox)
+		conditions = append(conditions, condition)
+		args = append(args, bboxArgs...)
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// tagsCondition arma la condición de etiquetas: con TagMatchAny basta una coincidencia y con
+// TagMatchAll el proyecto debe tener todas las etiquetas indicadas
+func tagsCondition(slugs []string, match string) (string, []interface{}) {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(slugs)), ", ")
+	args := make([]interface{}, 0, len(slugs)+1)
+	for _, slug := range slugs {
+		args = append(args, slug)
+	}
+
+	subquery := `SELECT pt.project_id FROM project_tags pt
+		JOIN tags t ON t.Id = pt.tag_id
+		WHERE t.slug IN (` + placeholders + `)`
+	if match == entities.TagMatchAny {
+		return "Id IN (" + subquery + ")", args
+	}
+	args = append(args, len(slugs))
+	return "Id IN (" + subquery + " GROUP BY pt.project_id HAVING COUNT(DISTINCT pt.tag_id) = ?)", args
+}
+
+// scanProject lee una fila con las columnas de projectSelectColumns seguidas de los destinos extra
+func scanProject(rows *sql.Rows, extra ...interface{}) (entities.Project, error) {
+	var project entities.Project
+	var geometry sql.NullString
+	var address entities.ProjectAddress
+	var geocodedAt sql.NullTime
+	var checklist sql.NullString
+	dest := []interface{}{&project.Id, &project.NombreProyecto, &project.Fecha, &project.Categoria, &project.Descripcion, &project.Img, &project.Lat, &project.Lng, &project.UserId, &project.CreatedAt, &project.UpdatedAt, &project.Version, &project.CategoryId, &project.PointCount, &geometry, &project.AreaM2, &project.PerimeterM,
+		&address.Municipality, &address.State, &address.Country, &address.CountryCode, &geocodedAt, &project.Status, &checklist, &project.TemplateId}
+	if err := rows.Scan(append(dest, extra...)...); err != nil {
+		return project, err
+	}
+	// Sin geocoded_at el proyecto todavía no se ha geocodificado
+	if geocodedAt.Valid {
+		address.GeocodedAt = geocodedAt.Time
+		project.Address = &address
+	}
+	if geometry.Valid {
+		project.Geometry = &entities.ProjectGeometry{}
+		if err := json.Unmarshal([]byte(geometry.String), project.Geometry); err != nil {
+			return project, fmt.Errorf("geometría guardada inválida en el proyecto %d: %w", project.Id, err)
+		}
+	}
+	if checklist.Valid {
+		if err := json.Unmarshal([]byte(checklist.String), &project.Checklist); err != nil {
+			return project, fmt.Errorf("lista de verificación guardada inválida en el proyecto %d: %w", project.Id, err)
+		}
+	}
+	return project, nil
+}
+
+// checklistColumnValue serializa la lista de verificación como JSON; una lista vacía se guarda como NULL
+func checklistColumnValue(checklist []entities.ChecklistItem) (interface{}, error) {
+	if len(checklist) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(checklist)
+	if err != nil {
+		return nil, fmt.Errorf("error al serializar la lista de verificación: %w", err)
+	}
+	return string(raw), nil
+}
+
+// geometryValue serializa la geometría como GeoJSON para la columna geometry; nil se guarda como NULL
+func geometryValue(geometry *entities.ProjectGeometry) (interface{}, error) {
+	if geometry == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(geometry)
+	if err != nil {
+		return nil, fmt.Errorf("error al serializar la geometría: %w", err)
+	}
+	return string(raw), nil
+}
+
+func (r *ProjectMySQLRepository) FindFiltered(filter entities.ProjectFilter) (*entities.ProjectPage, error) {
+	column, ok := sortColumns[filter.SortField]
+	if !ok {
+		return nil, fmt.Errorf("%w: no se puede ordenar por %q", entities.ErrInvalidFilter, filter.SortField)
+	}
+
+	where, args := buildProjectFilterWhere(filter)
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM projects` + where
+	if err := r.db.DB.QueryRow(countQuery, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("error al contar proyectos: %w", err)
+	}
+
+	direction, comparator := "ASC", ">"
+	if filter.SortDesc {
+		direction, comparator = "DESC", "<"
+	}
+
+	pageArgs := append([]interface{}{}, args...)
+	if filter.Cursor != "" {
+		cursor, err := decodeProjectCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		keyset := fmt.Sprintf("(Id %s ?)", comparator)
+		keysetArgs := []interface{}{cursor.Id}
+		if column != "Id" {
+			keyset = fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND Id %[2]s ?))", column, comparator)
+			keysetArgs = []interface{}{cursor.Value, cursor.Value, cursor.Id}
+		}
+		if where == "" {
+			where = " WHERE " + keyset
+		} else {
+			where += " AND " + keyset
+		}
+		pageArgs = append(pageArgs, keysetArgs...)
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM projects%s ORDER BY %s %s, Id %s LIMIT ?`,
+		projectSelectColumns, where, column, direction, direction)
+	pageArgs = append(pageArgs, filter.Limit+1)
+
+	rows, err := r.db.DB.Query(query, pageArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error al listar proyectos: %w", err)
+	}
+	defer rows.Close()
+
+	projects := make([]entities.Project, 0, filter.Limit)
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error al escanear proyecto: %w", err)
+		}
+		projects = append(projects, project)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar proyectos: %w", err)
+	}
+
+	page := &entities.ProjectPage{
+		Total: total,
+		Limit: filter.Limit,
+	}
+
+	// Se pidió un registro extra para saber si existe una página siguiente
+	if len(projects) > filter.Limit {
+		projects = projects[:filter.Limit]
+		last := projects[len(projects)-1]
+		page.NextCursor = encodeProjectCursor(projectCursor{
+			Value: sortValue(last, filter.SortField),
+			Id:    last.Id,
+		})
+	}
+	page.Projects = projects
+
+	return page, nil
+}
+
+// StreamFiltered recorre todos los proyectos que cumplen los filtros, sin paginar, uno a la vez.
+// Se ignoran Cursor y Limit; si fn devuelve un error el recorrido se detiene
+func (r *ProjectMySQLRepository) StreamFiltered(filter entities.ProjectFilter, fn func(entities.Project) error) error {
+	column, ok := sortColumns[filter.SortField]
+	if !ok {
+		return fmt.Errorf("%w: no se puede ordenar por %q", entities.ErrInvalidFilter, filter.SortField)
+	}
+	direction := "ASC"
+	if filter.SortDesc {
+		direction = "DESC"
+	}
+
+	where, args := buildProjectFilterWhere(filter)
+	query := fmt.Sprintf(`SELECT %s FROM projects%s ORDER BY %s %s, Id %s`,
+		projectSelectColumns, where, column, direction, direction)
+
+	rows, err := r.db.DB.Query(query, args...)
+	if err != nil {
+		return fmt.Errorf("error al listar proyectos: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return fmt.Errorf("error al escanear proyecto: %w", err)
+		}
+		if err := fn(project); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error al iterar proyectos: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// La columna Ubicacion es un POINT SRID 4326 generado a partir de Lat/Lng con índice SPATIAL.
+// Las geometrías se construyen en orden longitud-latitud para no depender del orden de ejes del SRS
+const geomFromText = `ST_GeomFromText(?, 4326, 'axis-order=long-lat')`
+
+// nearestSearchRadii son los radios (en metros) que se prueban en orden para los k vecinos más cercanos
+var nearestSearchRadii = []float64{1000, 10000, 100000, 1000000}
+
+func pointWKT(lat, lng float64) string {
+	return fmt.Sprintf("POINT(%f %f)", lng, lat)
+}
+
+func polygonWKT(minLng, minLat, maxLng, maxLat float64) string {
+	return fmt.Sprintf("POLYGON((%[1]f %[2]f, %[3]f %[2]f, %[3]f %[4]f, %[1]f %[4]f, %[1]f %[2]f))",
+		minLng, minLat, maxLng, maxLat)
+}
+
+// bboxCondition arma la condición que aprovecha el índice SPATIAL para un rectángulo.
+// Si el rectángulo cruza el antimeridiano se divide en dos
+func bboxCondition(bbox entities.BoundingBox) (string, []interface{}) {
+	if bbox.MinLng <= bbox.MaxLng {
+		return "MBRContains(" + geomFromText + ", Ubicacion)",
+			[]interface{}{polygonWKT(bbox.MinLng, bbox.MinLat, bbox.MaxLng, bbox.MaxLat)}
+	}
+	return "(MBRContains(" + geomFromText + ", Ubicacion) OR MBRContains(" + geomFromText + ", Ubicacion))",
+		[]interface{}{
+			polygonWKT(bbox.MinLng, bbox.MinLat, 180, bbox.MaxLat),
+			polygonWKT(-180, bbox.MinLat, bbox.MaxLng, bbox.MaxLat),
+		}
+}
+
+func (r *ProjectMySQLRepository) queryProjects(query string, args ...interface{}) ([]entities.Project, error) {
+	rows, err := r.db.DB.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar proyectos: %w", err)
+	}
+	defer rows.Close()
+
+	projects := make([]entities.Project, 0)
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error al escanear proyecto: %w", err)
+		}
+		projects = append(projects, project)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar proyectos: %w", err)
+	}
+	return projects, nil
+}
+
+func (r *ProjectMySQLRepository) FindNear(lat, lng, radiusMeters float64, limit int) ([]entities.Project, error) {
+	where, args := bboxCondition(services.BoundingBoxAround(lat, lng, radiusMeters))
+	point := pointWKT(lat, lng)
+
+	query := `SELECT ` + projectSelectColumns + ` FROM projects
+		WHERE ` + where + `
+			AND ST_Distance_Sphere(Ubicacion, ` + geomFromText + `, ?) <= ?
+		ORDER BY ST_Distance_Sphere(Ubicacion, ` + geomFromText + `, ?), Id
+		LIMIT ?`
+	args = append(args, point, services.EarthRadiusMeters, radiusMeters, point, services.EarthRadiusMeters, limit)
+
+	return r.queryProjects(query, args...)
+}
+
+func (r *ProjectMySQLRepository) FindWithin(bbox entities.BoundingBox, limit int) ([]entities.Project, error) {
+	where, args := bboxCondition(bbox)
+	query := `SELECT ` + projectSelectColumns + ` FROM projects WHERE ` + where + ` ORDER BY Id DESC LIMIT ?`
+	args = append(args, limit)
+
+	return r.queryProjects(query, args...)
+}
+
+func (r *ProjectMySQLRepository) FindNearest(lat, lng float64, k int) ([]entities.Project, error) {
+	// Amplía el radio de búsqueda hasta reunir k proyectos para seguir usando el índice SPATIAL
+	for _, radius := range nearestSearchRadii {
+		projects, err := r.FindNear(lat, lng, radius, k)
+		if err != nil {
+			return nil, err
+		}
+		if len(projects) >= k {
+			return projects, nil
+		}
+	}
+
+	point := pointWKT(lat, lng)
+	query := `SELECT ` + projectSelectColumns + ` FROM projects
+		ORDER BY ST_Distance_Sphere(Ubicacion, ` + geomFromText + `, ?), Id
+		LIMIT ?`
+	return r.queryProjects(query, point, services.EarthRadiusMeters, k)
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// mapCellColumns calcula en SQL la celda Web Mercator de cada proyecto, con la misma fórmula que
+// services.MapCell. Cada ? recibe el tamaño de la cuadrícula
+func mapCellColumns() string {
+	maxLat := strconv.FormatFloat(services.MercatorMaxLat, 'f', -1, 64)
+	phi := "RADIANS(LEAST(GREATEST(Lat, -" + maxLat + "), " + maxLat + "))"
+	return `LEAST(GREATEST(FLOOR((Lng + 180) / 360 * ?), 0), ? - 1) AS cell_x,
+		LEAST(GREATEST(FLOOR((1 - LN(TAN(` + phi + `) + 1 / COS(` + phi + `)) / PI()) / 2 * ?), 0), ? - 1) AS cell_y`
+}
+
+func (r *ProjectMySQLRepository) ClusterWithin(query entities.ProjectMapQuery) ([]entities.ProjectCluster, error) {
+	where, whereArgs := buildProjectFilterWhere(query.Filter)
+	sqlQuery := `SELECT COUNT(*), AVG(Lat), AVG(Lng), MIN(Lat), MIN(Lng), MAX(Lat), MAX(Lng), MIN(Id)
+		FROM (SELECT Id, Lat, Lng, ` + mapCellColumns() + ` FROM projects` + where + `) AS cells
+		GROUP BY cell_x, cell_y
+		ORDER BY COUNT(*) DESC, MIN(Id)`
+	args := []interface{}{query.GridSize, query.GridSize, query.GridSize, query.GridSize}
+	args = append(args, whereArgs...)
+
+	rows, err := r.db.DB.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error al agrupar proyectos: %w", err)
+	}
+	defer rows.Close()
+
+	clusters := make([]entities.ProjectCluster, 0)
+	for rows.Next() {
+		var cluster entities.ProjectCluster
+		var firstId int
+		if err := rows.Scan(&cluster.Count, &cluster.Lat, &cluster.Lng,
+			&cluster.Bounds.MinLat, &cluster.Bounds.MinLng, &cluster.Bounds.MaxLat, &cluster.Bounds.MaxLng, &firstId); err != nil {
+			return nil, fmt.Errorf("error al escanear grupo de proyectos: %w", err)
+		}
+		if cluster.Count == 1 {
+			cluster.ProjectId = firstId
+		}
+		clusters = append(clusters, cluster)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar grupos de proyectos: %w", err)
+	}
+	return clusters, nil
+}
+
+func (r *ProjectMySQLRepository) FindMapPoints(filter entities.ProjectFilter, limit int) ([]entities.MapPoint, error) {
+	where, args := buildProjectFilterWhere(filter)
+	query := `SELECT Id, NombreProyecto, Categoria, status, Lat, Lng FROM projects` + where + ` ORDER BY Id DESC LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := r.db.DB.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar proyectos del mapa: %w", err)
+	}
+	defer rows.Close()
+
+	points := make([]entities.MapPoint, 0)
+	for rows.Next() {
+		var point entities.MapPoint
+		if err := rows.Scan(&point.Id, &point.NombreProyecto, &point.Categoria, &point.Status, &point.Lat, &point.Lng); err != nil {
+			return nil, fmt.Errorf("error al escanear proyecto del mapa: %w", err)
+		}
+		points = append(points, point)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar proyectos del mapa: %w", err)
+	}
+	return points, nil
+}
@@ -1,10 +1,12 @@
 ﻿package repository
 
 import (
+"database/sql"
 "fmt"
 
 "github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
 "github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
 "github.com/JosephAntony37900/Geova-back-1/core"
 "time"
 )
@@ -19,24 +21,72 @@ db: db,
 }
 }
 
-func (r *ProjectMySQLRepository) Save(project entities.Project) error {
-query := `INSERT INTO projects (NombreProyecto, Fecha, Categoria, Descripcion, Img, Lat, Lng, user_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
-_, err := r.db.ExecutePreparedQuery(query, project.NombreProyecto, project.Fecha, project.Categoria, project.Descripcion, project.Img, project.Lat, project.Lng, project.UserId)
+func (r *ProjectMySQLRepository) Save(project entities.Project, revision entities.ProjectRevision) (int, error) {
+now := time.Now().UTC()
+geometry, err := geometryValue(project.Geometry)
+if err != nil {
+return 0, err
+}
+checklist, err := checklistColumnValue(project.Checklist)
+if err != nil {
+return 0, err
+}
+query := `INSERT INTO projects (NombreProyecto, Fecha, Categoria, category_id, Descripcion, Img, Lat, Lng, geometry, area_m2, perimeter_m, status, checklist, template_id, user_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+var id int64
+err = inTransaction(r.db, func(tx *sql.Tx) error {
+result, err := tx.Exec(query, project.NombreProyecto, project.Fecha, project.Categoria, project.CategoryId, project.Descripcion, project.Img, project.Lat, project.Lng, geometry, project.AreaM2, project.PerimeterM, project.Status, checklist, nullableInt(project.TemplateId), project.UserId, now, now)
 if err != nil {
 return fmt.Errorf("error al guardar proyecto: %w", err)
 }
-return nil
+id, err = result.LastInsertId()
+if err != nil {
+return fmt.Errorf("error al obtener el ID del proyecto: %w", err)
+}
+// La primera revisión del historial es el proyecto tal como se creó
+saved, err := findProjectTx(tx, int(id), false)
+if err != nil {
+return err
+}
+return insertRevision(tx, services.NewProjectRevision(entities.Project{}, *saved, revision))
+})
+if err != nil {
+return 0, err
+}
+return int(id), nil
 }
 
-func (r *ProjectMySQLRepository) Update(project entities.Project) error {
-existingProject, err := r.FindById(project.Id)
-if err != nil || existingProject == nil {
-return fmt.Errorf("el proyecto con ID %d no existe", project.Id)
+func (r *ProjectMySQLRepository) Update(project entities.Project, revision entities.ProjectRevision) error {
+geometry, err := geometryValue(project.Geometry)
+if err != nil {
+return err
+}
+checklist, err := checklistColumnValue(project.Checklist)
+if err != nil {
+return err
+}
+// Solo se actualiza si la versión no cambió desde que el cliente leyó el proyecto
+query := `UPDATE projects SET NombreProyecto = ?, Fecha = ?, Categoria = ?, category_id = ?, Descripcion = ?, Img = ?, Lat = ?, Lng = ?, geometry = ?, area_m2 = ?, perimeter_m = ?, checklist = ?, user_id = ?, updated_at = ?, version = version + 1 WHERE Id = ? AND version = ?`
+target := entities.BulkTarget{Id: project.Id, Version: project.Version}
+return inTransaction(r.db, func(tx *sql.Tx) error {
+return execRevisioned(tx, target, revision, query, project.NombreProyecto, project.Fecha, project.Categoria, project.CategoryId, project.Descripcion, project.Img, project.Lat, project.Lng, geometry, project.AreaM2, project.PerimeterM, checklist, project.UserId, time.Now().UTC(), project.Id, project.Version)
+})
 }
-query := `UPDATE projects SET NombreProyecto = ?, Fecha = ?, Categoria = ?, Descripcion = ?, Img = ?, Lat = ?, Lng = ?, user_id = ? WHERE Id = ?`
-_, err = r.db.ExecutePreparedQuery(query, project.NombreProyecto, project.Fecha, project.Categoria, project.Descripcion, project.Img, project.Lat, project.Lng, project.UserId, project.Id)
+
+func (r *ProjectMySQLRepository) UpdateAddress(id int, address entities.ProjectAddress) error {
+query := `UPDATE projects SET municipality = ?, state = ?, country = ?, country_code = ?, geocoded_at = ? WHERE Id = ?`
+result, err := r.db.ExecutePreparedQuery(query, address.Municipality, address.State, address.Country, address.CountryCode, address.GeocodedAt.UTC(), id)
 if err != nil {
-return fmt.Errorf("error al actualizar proyecto: %w", err)
+return fmt.Errorf("error al guardar la dirección del proyecto: %w", err)
+}
+affected, err := result.RowsAffected()
+if err != nil {
+return fmt.Errorf("error al guardar la dirección del proyecto: %w", err)
+}
+if affected == 0 {
+// RowsAffected es 0 también si la dirección no cambió, por lo que se confirma que el proyecto exista
+if _, err := r.FindById(id); err != nil {
+return err
+}
 }
 return nil
 }
@@ -55,136 +105,82 @@ return nil
 }
 
 func (r *ProjectMySQLRepository) FindById(id int) (*entities.Project, error) {
-query := `SELECT Id, NombreProyecto, Fecha, Categoria, Descripcion, Img, Lat, Lng, user_id FROM projects WHERE Id = ?`
-rows := r.db.FetchRows(query, id)
-defer rows.Close()
-if rows.Next() {
-var project entities.Project
-err := rows.Scan(&project.Id, &project.NombreProyecto, &project.Fecha, &project.Categoria, &project.Descripcion, &project.Img, &project.Lat, &project.Lng, &project.UserId)
+query := `SELECT ` + projectSelectColumns + ` FROM projects WHERE Id = ?`
+projects, err := r.queryProjects(query, id)
 if err != nil {
 return nil, err
 }
-return &project, nil
+if len(projects) == 0 {
+return nil, fmt.Errorf("proyecto %w", entities.ErrNotFound)
 }
-return nil, fmt.Errorf("proyecto no encontrado")
+return &projects[0], nil
 }
 
 func (r *ProjectMySQLRepository) FindAll() ([]entities.Project, error) {
-query := `SELECT Id, NombreProyecto, Fecha, Categoria, Descripcion, Img, Lat, Lng, user_id FROM projects ORDER BY Id DESC`
-rows := r.db.FetchRows(query)
-defer rows.Close()
-var projects []entities.Project
-for rows.Next() {
-var project entities.Project
-err := rows.Scan(&project.Id, &project.NombreProyecto, &project.Fecha, &project.Categoria, &project.Descripcion, &project.Img, &project.Lat, &project.Lng, &project.UserId)
-if err != nil {
-return nil, err
-}
-projects = append(projects, project)
-}
-return projects, nil
+query := `SELECT ` + projectSelectColumns + ` FROM projects ORDER BY Id DESC`
+return r.queryProjects(query)
 }
 
 func (r *ProjectMySQLRepository) FindByName(nombre string) ([]entities.Project, error) {
-query := `SELECT Id, NombreProyecto, Fecha, Categoria, Descripcion, Img, Lat, Lng, user_id FROM projects WHERE NombreProyecto LIKE ? ORDER BY Id DESC`
-rows := r.db.FetchRows(query, "%"+nombre+"%")
-defer rows.Close()
-var projects []entities.Project
-for rows.Next() {
-var project entities.Project
-err := rows.Scan(&project.Id, &project.NombreProyecto, &project.Fecha, &project.Categoria, &project.Descripcion, &project.Img, &project.Lat, &project.Lng, &project.UserId)
-if err != nil {
-return nil, err
-}
-projects = append(projects, project)
-}
-return projects, nil
+query := `SELECT ` + projectSelectColumns + ` FROM projects WHERE NombreProyecto LIKE ? ORDER BY Id DESC`
+return r.queryProjects(query, "%"+nombre+"%")
 }
 
-func (r *ProjectMySQLRepository) FindByCategory(categoria string) ([]entities.Project, error) {
-query := `SELECT Id, NombreProyecto, Fecha, Categoria, Descripcion, Img, Lat, Lng, user_id FROM projects WHERE Categoria = ? ORDER BY Id DESC`
-rows := r.db.FetchRows(query, categoria)
-defer rows.Close()
-var projects []entities.Project
-for rows.Next() {
-var project entities.Project
-err := rows.Scan(&project.Id, &project.NombreProyecto, &project.Fecha, &project.Categoria, &project.Descripcion, &project.Img, &project.Lat, &project.Lng, &project.UserId)
-if err != nil {
-return nil, err
-}
-projects = append(projects, project)
-}
-return projects, nil
+func (r *ProjectMySQLRepository) FindByCategory(slug string) ([]entities.Project, error) {
+query := `SELECT ` + projectSelectColumns + ` FROM projects WHERE category_id = (SELECT Id FROM categories WHERE slug = ?) ORDER BY Id DESC`
+return r.queryProjects(query, slug)
 }
 
-func (r *ProjectMySQLRepository) FindByDate(fecha string) ([]entities.Project, error) {
-query := `SELECT Id, NombreProyecto, Fecha, Categoria, Descripcion, Img, Lat, Lng, user_id FROM projects WHERE Fecha = ? ORDER BY Id DESC`
-rows := r.db.FetchRows(query, fecha)
-defer rows.Close()
-var projects []entities.Project
-for rows.Next() {
-var project entities.Project
-err := rows.Scan(&project.Id, &project.NombreProyecto, &project.Fecha, &project.Categoria, &project.Descripcion, &project.Img, &project.Lat, &project.Lng, &project.UserId)
-if err != nil {
-return nil, err
-}
-projects = append(projects, project)
-}
-return projects, nil
+func (r *ProjectMySQLRepository) FindByDateRange(dateQuery entities.ProjectDateQuery) ([]entities.Project, error) {
+where, args := buildProjectFilterWhere(entities.ProjectFilter{
+FechaDesde: dateQuery.Range.From,
+FechaHasta: dateQuery.Range.To,
+})
+query := `SELECT ` + projectSelectColumns + ` FROM projects` + where + ` ORDER BY Fecha DESC, Id DESC LIMIT ?`
+args = append(args, dateQuery.Limit)
+return r.queryProjects(query, args...)
 }
 
 func (r *ProjectMySQLRepository) FindByUserId(userId int) ([]entities.Project, error) {
-query := `SELECT Id, NombreProyecto, Fecha, Categoria, Descripcion, Img, Lat, Lng, user_id FROM projects WHERE user_id = ? ORDER BY Id DESC`
-rows := r.db.FetchRows(query, userId)
-defer rows.Close()
-var projects []entities.Project
-for rows.Next() {
-var project entities.Project
-err := rows.Scan(&project.Id, &project.NombreProyecto, &project.Fecha, &project.Categoria, &project.Descripcion, &project.Img, &project.Lat, &project.Lng, &project.UserId)
-if err != nil {
-return nil, err
+query := `SELECT ` + projectSelectColumns + ` FROM projects WHERE user_id = ? ORDER BY Id DESC`
+return r.queryProjects(query, userId)
 }
-projects = append(projects, project)
-}
-return projects, nil
-}
-func (r *ProjectMySQLRepository) GetProjectsStats(userId int, days int) ([]entities.DailyProjectCount, error) {
+func (r *ProjectMySQLRepository) StreamStatsRecords(userId int, dateRange entities.DateRange, fn func(entities.ProjectStatsRecord) error) error {
+    // Los periodos se agrupan en Go según la zona horaria del cliente; MySQL solo filtra el rango en UTC
     query := `
-        SELECT 
-            DATE(Fecha) as date,
-            COUNT(*) as count
+        SELECT Fecha, category_id, Categoria, status, user_id
         FROM projects
-        WHERE user_id = ?
-            AND Fecha >= DATE_SUB(CURDATE(), INTERVAL ? DAY)
-            AND Fecha < CURDATE() + INTERVAL 1 DAY
-        GROUP BY DATE(Fecha)
-        ORDER BY date DESC
+        WHERE Fecha >= ?
+            AND Fecha < ?
     `
+    args := []interface{}{dateRange.From.UTC(), dateRange.To.UTC()}
+    if userId > 0 {
+        query += ` AND user_id = ?`
+        args = append(args, userId)
+    }
 
-    rows, err := r.db.DB.Query(query, userId, days)
+    rows, err := r.db.DB.Query(query, args...)
     if err != nil {
-        return nil, fmt.Errorf("error al consultar estadísticas diarias: %w", err)
+        return fmt.Errorf("error al consultar estadísticas: %w", err)
     }
     defer rows.Close()
 
-    var results []entities.DailyProjectCount
     for rows.Next() {
-        var dailyCount entities.DailyProjectCount
-        var dateTime time.Time
+        var record entities.ProjectStatsRecord
         
-        if err := rows.Scan(&dateTime, &dailyCount.Count); err != nil {
-            return nil, fmt.Errorf("error al escanear fila: %w", err)
+        if err := rows.Scan(&record.Fecha, &record.CategoryId, &record.Categoria, &record.Status, &record.UserId); err != nil {
+            return fmt.Errorf("error al escanear fila: %w", err)
         }
         
-        dailyCount.Date = dateTime.Format("2006-01-02")
-        results = append(results, dailyCount)
+        if err := fn(record); err != nil {
+            return err
+        }
     }
 
     if err := rows.Err(); err != nil {
-        return nil, fmt.Errorf("error al iterar resultados: %w", err)
+        return fmt.Errorf("error al iterar resultados: %w", err)
     }
-
-    return results, nil
+    return nil
 }
 
 func (r *ProjectMySQLRepository) GetTotalProjectsByUser(userId string) (int, error) {
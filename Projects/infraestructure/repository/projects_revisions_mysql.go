@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+	"github.com/JosephAntony37900/Geova-back-1/core"
+)
+
+// ProjectRevisionMySQLRepository consulta el historial. Cada revisión es una fila de solo inserción con
+// la copia completa del proyecto en JSON, que guarda execRevisioned en la transacción del cambio
+type ProjectRevisionMySQLRepository struct {
+	db *core.Conn_MySQL
+}
+
+func NewProjectRevisionMySQLRepository(db *core.Conn_MySQL) repository.ProjectRevisionRepository {
+	return &ProjectRevisionMySQLRepository{db: db}
+}
+
+const revisionSelectColumns = `Id, project_id, revision, action, editor_id, changed_fields, previous_img, restored_from, snapshot, created_at`
+
+// nullableInt guarda 0 como NULL
+func nullableInt(value int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(value), Valid: value != 0}
+}
+
+// findProjectTx lee el proyecto dentro de tx; forUpdate bloquea su fila hasta que termine la transacción
+func findProjectTx(tx *sql.Tx, id int, forUpdate bool) (*entities.Project, error) {
+	query := `SELECT ` + projectSelectColumns + ` FROM projects WHERE Id = ?`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+	rows, err := tx.Query(query, id)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar proyecto: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("error al consultar proyecto: %w", err)
+		}
+		return nil, fmt.Errorf("proyecto %w", entities.ErrNotFound)
+	}
+	project, err := scanProject(rows)
+	if err != nil {
+		return nil, fmt.Errorf("error al escanear proyecto: %w", err)
+	}
+	return &project, nil
+}
+
+// insertRevision guarda la revisión con el número que ya trae asignado
+func insertRevision(tx *sql.Tx, revision entities.ProjectRevision) error {
+	changedFields, err := json.Marshal(revision.ChangedFields)
+	if err != nil {
+		return fmt.Errorf("error al serializar los campos modificados: %w", err)
+	}
+	snapshot, err := json.Marshal(revision.Snapshot)
+	if err != nil {
+		return fmt.Errorf("error al serializar la revisión: %w", err)
+	}
+
+	query := `INSERT INTO project_revisions (project_id, revision, action, editor_id, changed_fields, previous_img, restored_from, snapshot, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err = tx.Exec(query,
+		revision.ProjectId, revision.Revision, revision.Action, nullableInt(revision.EditorId), string(changedFields),
+		revision.PreviousImg, nullableInt(revision.RestoredFrom), string(snapshot), time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("error al guardar la revisión %d del proyecto %d: %w", revision.Revision, revision.ProjectId, err)
+	}
+	return nil
+}
+
+// execRevisioned ejecuta con execVersioned una sentencia que modifica el proyecto de target y guarda la
+// revisión del cambio en la misma transacción. Si el proyecto aún no tenía historial, antes se guarda su
+// estado anterior como revisión inicial. El número de revisión es la nueva versión del proyecto: la fila
+// queda bloqueada y el UPDATE exige la versión anterior, por lo que dos cambios no obtienen el mismo número
+func execRevisioned(tx *sql.Tx, target entities.BulkTarget, change entities.ProjectRevision, query string, args ...interface{}) error {
+	before, err := findProjectTx(tx, target.Id, true)
+	if err != nil {
+		return err
+	}
+	if err := execVersioned(tx, target, query, args...); err != nil {
+		return err
+	}
+	after, err := findProjectTx(tx, target.Id, false)
+	if err != nil {
+		return err
+	}
+
+	var exists bool
+	if err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM project_revisions WHERE project_id = ?)`, target.Id).Scan(&exists); err != nil {
+		return fmt.Errorf("error al consultar revisiones: %w", err)
+	}
+	if !exists {
+		if err := insertRevision(tx, services.BaselineRevision(*before)); err != nil {
+			return err
+		}
+	}
+	return insertRevision(tx, services.NewProjectRevision(*before, *after, change))
+}
+
+func (r *ProjectRevisionMySQLRepository) FindRevisions(projectId, before, limit int) ([]entities.ProjectRevision, error) {
+	query := `SELECT ` + revisionSelectColumns + ` FROM project_revisions WHERE project_id = ?`
+	args := []interface{}{projectId}
+	if before > 0 {
+		query += ` AND revision < ?`
+		args = append(args, before)
+	}
+	query += ` ORDER BY revision DESC LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := r.db.DB.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar revisiones: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := make([]entities.ProjectRevision, 0)
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error al escanear revisión: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar revisiones: %w", err)
+	}
+	return revisions, nil
+}
+
+func (r *ProjectRevisionMySQLRepository) FindRevision(projectId, number int) (*entities.ProjectRevision, error) {
+	query := `SELECT ` + revisionSelectColumns + ` FROM project_revisions WHERE project_id = ? AND revision = ?`
+	rows, err := r.db.DB.Query(query, projectId, number)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar revisión: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("error al consultar revisión: %w", err)
+		}
+		return nil, fmt.Errorf("%w: revisión %d del proyecto %d", entities.ErrNotFound, number, projectId)
+	}
+	revision, err := scanRevision(rows)
+	if err != nil {
+		return nil, fmt.Errorf("error al escanear revisión: %w", err)
+	}
+	return &revision, nil
+}
+
+func scanRevision(rows *sql.Rows) (entities.ProjectRevision, error) {
+	var revision entities.ProjectRevision
+	var editorId, restoredFrom sql.NullInt64
+	var previousImg sql.NullString
+	var changedFields, snapshot []byte
+
+	err := rows.Scan(&revision.Id, &revision.ProjectId, &revision.Revision, &revision.Action, &editorId,
+		&changedFields, &previousImg, &restoredFrom, &snapshot, &revision.CreatedAt)
+	if err != nil {
+		return revision, err
+	}
+	revision.EditorId = int(editorId.Int64)
+	revision.RestoredFrom = int(restoredFrom.Int64)
+	revision.PreviousImg = previousImg.String
+
+	if err := json.Unmarshal(changedFields, &revision.ChangedFields); err != nil {
+		return revision, fmt.Errorf("campos modificados inválidos: %w", err)
+	}
+	if err := json.Unmarshal(snapshot, &revision.Snapshot); err != nil {
+		return revision, fmt.Errorf("copia del proyecto inválida: %w", err)
+	}
+	return revision, nil
+}
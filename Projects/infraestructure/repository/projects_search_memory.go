@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// Pesos de cada campo en la relevancia, igual que en la búsqueda FULLTEXT
+const (
+	nombreFieldWeight      = 2.0
+	descripcionFieldWeight = 1.0
+)
+
+// ProjectMemorySearchIndex es un índice invertido en memoria, sin dependencias de base de datos.
+// Sirve como alternativa a MySQL FULLTEXT en pruebas y entornos locales
+type ProjectMemorySearchIndex struct {
+	mu       sync.RWMutex
+	projects map[int]entities.Project
+	// postings relaciona cada término con el peso acumulado por proyecto
+	postings map[string]map[int]float64
+}
+
+func NewProjectMemorySearchIndex() *ProjectMemorySearchIndex {
+	return &ProjectMemorySearchIndex{
+		projects: make(map[int]entities.Project),
+		postings: make(map[string]map[int]float64),
+	}
+}
+
+// Index agrega o reemplaza un proyecto en el índice
+func (idx *ProjectMemorySearchIndex) Index(project entities.Project) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(project.Id)
+	idx.projects[project.Id] = project
+
+	for _, field := range []struct {
+		text   string
+		weight float64
+	}{
+		{project.NombreProyecto, nombreFieldWeight},
+		{project.Descripcion, descripcionFieldWeight},
+	} {
+		for _, term := range services.Tokenize(field.text) {
+			if idx.postings[term] == nil {
+				idx.postings[term] = make(map[int]float64)
+			}
+			idx.postings[term][project.Id] += field.weight
+		}
+	}
+}
+
+// Remove elimina un proyecto del índice
+func (idx *ProjectMemorySearchIndex) Remove(id int) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.removeLocked(id)
+}
+
+func (idx *ProjectMemorySearchIndex) removeLocked(id int) {
+	if _, exists := idx.projects[id]; !exists {
+		return
+	}
+	delete(idx.projects, id)
+	for term, docs := range idx.postings {
+		delete(docs, id)
+		if len(docs) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+}
+
+func (idx *ProjectMemorySearchIndex) Search(query entities.ProjectSearchQuery) ([]entities.ProjectSearchResult, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	total := float64(len(idx.projects))
+	scores := make(map[int]float64)
+
+	for _, queryTerm := range query.Terms {
+		for term, docs := range idx.postings {
+			match := services.MatchTerm(term, queryTerm)
+			if match == 0 {
+				continue
+			}
+			// Los términos poco frecuentes aportan más relevancia
+			idf := math.Log(1 + total/float64(len(docs)))
+			for id, weight := range docs {
+				if query.UserId > 0 && idx.projects[id].UserId != query.UserId {
+					continue
+				}
+				scores[id] += match * weight * idf
+			}
+		}
+	}
+
+	results := make([]entities.ProjectSearchResult, 0, len(scores))
+	for id, score := range scores {
+		results = append(results, entities.ProjectSearchResult{
+			Project: idx.projects[id],
+			Score:   score,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Project.Id > results[j].Project.Id
+	})
+
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+	return results, nil
+}
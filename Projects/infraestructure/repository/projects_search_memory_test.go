@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+func newSearchIndexFixture() *ProjectMemorySearchIndex {
+	index := NewProjectMemorySearchIndex()
+	index.Index(entities.Project{Id: 1, NombreProyecto: "Levantamiento topográfico Xochimilco", Descripcion: "Medición de canales y chinampas", UserId: 1})
+	index.Index(entities.Project{Id: 2, NombreProyecto: "Parque industrial", Descripcion: "Estudio TOPOGRAFICO para naves y vialidades", UserId: 2})
+	index.Index(entities.Project{Id: 3, NombreProyecto: "Deslinde catastral", Descripcion: "Predio rústico en Tlalpan", UserId: 1})
+	return index
+}
+
+func TestMemorySearchIndex_AccentInsensitiveAndRanked(t *testing.T) {
+	results, err := newSearchIndexFixture().Search(entities.ProjectSearchQuery{Terms: services.Tokenize("Topografico")})
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("se esperaban 2 resultados, obtenidos %d", len(results))
+	}
+	// La coincidencia en el nombre pesa más que en la descripción
+	if results[0].Project.Id != 1 {
+		t.Errorf("se esperaba el proyecto 1 primero, obtenido %d", results[0].Project.Id)
+	}
+}
+
+func TestMemorySearchIndex_TypoToleranceAndUser(t *testing.T) {
+	results, err := newSearchIndexFixture().Search(entities.ProjectSearchQuery{Terms: services.Tokenize("catastarl"), UserId: 1})
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if len(results) != 1 || results[0].Project.Id != 3 {
+		t.Fatalf("se esperaba solo el proyecto 3, obtenido %+v", results)
+	}
+}
+
+func TestMemorySearchIndex_Remove(t *testing.T) {
+	index := newSearchIndexFixture()
+	index.Remove(3)
+	results, _ := index.Search(entities.ProjectSearchQuery{Terms: services.Tokenize("catastral")})
+	if len(results) != 0 {
+		t.Errorf("el proyecto eliminado no debería aparecer: %+v", results)
+	}
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+	"github.com/JosephAntony37900/Geova-back-1/core"
+)
+
+// ProjectMySQLSearchRepository busca con los índices FULLTEXT de la tabla projects.
+// Las columnas usan la colación utf8mb4_0900_ai_ci, por lo que MySQL ignora acentos y mayúsculas
+type ProjectMySQLSearchRepository struct {
+	db *core.Conn_MySQL
+}
+
+func NewProjectMySQLSearchRepository(db *core.Conn_MySQL) repository.ProjectSearchRepository {
+	return &ProjectMySQLSearchRepository{db: db}
+}
+
+// booleanQuery arma la expresión de MATCH ... IN BOOLEAN MODE. Cada término se busca como
+// prefijo y, si admite errores tipográficos, también por su raíz recortada
+func booleanQuery(terms []string) string {
+	parts := make([]string, 0, len(terms)*2)
+	for _, term := range terms {
+		parts = append(parts, term+"*")
+		if tolerance := services.TypoTolerance(term); tolerance > 0 {
+			runes := []rune(term)
+			if stem := string(runes[:len(runes)-tolerance]); len(stem) >= 3 {
+				parts = append(parts, stem+"*")
+			}
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func (r *ProjectMySQLSearchRepository) Search(query entities.ProjectSearchQuery) ([]entities.ProjectSearchResult, error) {
+	against := booleanQuery(query.Terms)
+	if against == "" {
+		return []entities.ProjectSearchResult{}, nil
+	}
+
+	// El nombre pesa el doble que la descripción en la relevancia
+	sqlQuery := `SELECT ` + projectSelectColumns + `,
+			MATCH(NombreProyecto) AGAINST(? IN BOOLEAN MODE) * 2
+			+ MATCH(NombreProyecto, Descripcion) AGAINST(? IN BOOLEAN MODE) AS score
+		FROM projects
+		WHERE MATCH(NombreProyecto, Descripcion) AGAINST(? IN BOOLEAN MODE)`
+	args := []interface{}{against, against, against}
+	if query.UserId > 0 {
+		sqlQuery += ` AND user_id = ?`
+		args = append(args, query.UserId)
+	}
+	sqlQuery += ` ORDER BY score DESC, Id DESC LIMIT ?`
+	args = append(args, query.Limit)
+
+	rows, err := r.db.DB.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error al buscar proyectos: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]entities.ProjectSearchResult, 0)
+	for rows.Next() {
+		var score float64
+		project, err := scanProject(rows, &score)
+		if err != nil {
+			return nil, fmt.Errorf("error al escanear resultado de búsqueda: %w", err)
+		}
+		results = append(results, entities.ProjectSearchResult{Project: project, Score: score})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar resultados de búsqueda: %w", err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/repository"
+	"github.com/JosephAntony37900/Geova-back-1/core"
+)
+
+type TagMySQLRepository struct {
+	db *core.Conn_MySQL
+}
+
+func NewTagMySQLRepository(db *core.Conn_MySQL) repository.TagRepository {
+	return &TagMySQLRepository{db: db}
+}
+
+func (r *TagMySQLRepository) FindByProject(projectId int) ([]entities.Tag, error) {
+	query := `SELECT t.Id, t.slug, t.nombre FROM tags t
+		JOIN project_tags pt ON pt.tag_id = t.Id
+		WHERE pt.project_id = ?
+		ORDER BY t.nombre`
+	rows, err := r.db.DB.Query(query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar etiquetas: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make([]entities.Tag, 0)
+	for rows.Next() {
+		var tag entities.Tag
+		if err := rows.Scan(&tag.Id, &tag.Slug, &tag.Nombre); err != nil {
+			return nil, fmt.Errorf("error al escanear etiqueta: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar etiquetas: %w", err)
+	}
+	return tags, nil
+}
+
+func (r *TagMySQLRepository) AddToProject(projectId int, tags []entities.Tag) error {
+	tx, err := r.db.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("error al iniciar la transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	for _, tag := range tags {
+		// LAST_INSERT_ID(Id) hace que LastInsertId devuelva el Id de la etiqueta existente
+		result, err := tx.Exec(`INSERT INTO tags (slug, nombre, created_at) VALUES (?, ?, ?)
+			ON DUPLICATE KEY UPDATE Id = LAST_INSERT_ID(Id)`, tag.Slug, tag.Nombre, now)
+		if err != nil {
+			return fmt.Errorf("error al guardar la etiqueta %q: %w", tag.Nombre, err)
+		}
+		tagId, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("error al obtener el ID de la etiqueta: %w", err)
+		}
+		if _, err := tx.Exec(`INSERT IGNORE INTO project_tags (project_id, tag_id, created_at) VALUES (?, ?, ?)`, projectId, tagId, now); err != nil {
+			return fmt.Errorf("error al asignar la etiqueta %q: %w", tag.Nombre, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *TagMySQLRepository) RemoveFromProject(projectId int, slug string) error {
+	query := `DELETE pt FROM project_tags pt
+		JOIN tags t ON t.Id = pt.tag_id
+		WHERE pt.project_id = ? AND t.slug = ?`
+	result, err := r.db.ExecutePreparedQuery(query, projectId, slug)
+	if err != nil {
+		return fmt.Errorf("error al quitar la etiqueta: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return fmt.Errorf("etiqueta del proyecto %w", entities.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *TagMySQLRepository) Autocomplete(prefix string, limit int) ([]entities.Tag, error) {
+	query := `SELECT t.Id, t.slug, t.nombre, COUNT(*) AS uses FROM tags t
+		JOIN project_tags pt ON pt.tag_id = t.Id
+		WHERE t.slug LIKE ?
+		GROUP BY t.Id, t.slug, t.nombre
+		ORDER BY uses DESC, t.nombre
+		LIMIT ?`
+	rows, err := r.db.DB.Query(query, escapeLike(prefix)+"%", limit)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar etiquetas: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make([]entities.Tag, 0)
+	for rows.Next() {
+		var tag entities.Tag
+		if err := rows.Scan(&tag.Id, &tag.Slug, &tag.Nombre, &tag.Count); err != nil {
+			return nil, fmt.Errorf("error al escanear etiqueta: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error al iterar etiquetas: %w", err)
+	}
+	return tags, nil
+}
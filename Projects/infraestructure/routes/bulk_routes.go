@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpBulkRoutes registra las operaciones masivas sobre proyectos. Requieren un token válido: cada
+// usuario solo modifica sus proyectos, salvo los listados en ADMIN_USER_IDS
+func SetUpBulkRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	bulkProjects *controllers.BulkProjectsController,
+) {
+	writeRoutes := r.Group("/projects")
+	writeRoutes.Use(
+		limiters.Write.RateLimitMiddleware(),
+		auth.AuthMiddleware(os.Getenv("JWT_SECRET")),
+		controllers.IdentifyAdmin(os.Getenv("ADMIN_USER_IDS")),
+	)
+	{
+		writeRoutes.POST("/bulk", bulkProjects.Execute)
+	}
+}
@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpCategoriesRoutes registra el catálogo de categorías. La lectura es pública; las modificaciones
+// requieren un token válido de un usuario listado en ADMIN_USER_IDS
+func SetUpCategoriesRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	getCategories *controllers.GetCategoriesController,
+	createCategory *controllers.CreateCategoryController,
+	updateCategory *controllers.UpdateCategoryController,
+	deleteCategory *controllers.DeleteCategoryController,
+	setCategoryOverride *controllers.SetCategoryOverrideController,
+	deleteCategoryOverride *controllers.DeleteCategoryOverrideController,
+) {
+	readRoutes := r.Group("/categories")
+	readRoutes.Use(limiters.Read.RateLimitMiddleware())
+	{
+		readRoutes.GET("", getCategories.Execute)
+	}
+
+	adminRoutes := r.Group("/categories")
+	adminRoutes.Use(
+		limiters.Write.RateLimitMiddleware(),
+		auth.AuthMiddleware(os.Getenv("JWT_SECRET")),
+		controllers.RequireAdmin(os.Getenv("ADMIN_USER_IDS")),
+	)
+	{
+		adminRoutes.POST("", createCategory.Execute)
+		adminRoutes.PUT("/:id", updateCategory.Execute)
+		adminRoutes.DELETE("/:id", deleteCategory.Execute)
+		adminRoutes.PUT("/:id/overrides/:orgId", setCategoryOverride.Execute)
+		adminRoutes.DELETE("/:id/overrides/:orgId", deleteCategoryOverride.Execute)
+	}
+}
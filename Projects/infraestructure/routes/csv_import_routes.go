@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpCSVImportRoutes registra la importación de proyectos desde CSV y la consulta de sus trabajos
+func SetUpCSVImportRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	importProjectsCSV *controllers.ImportProjectsCSVController,
+	getImportJob *controllers.GetImportJobController,
+) {
+	readRoutes := r.Group("/projects")
+	readRoutes.Use(limiters.Read.RateLimitMiddleware())
+	{
+		readRoutes.GET("/import/jobs/:jobId", getImportJob.Execute)
+	}
+
+	writeRoutes := r.Group("/projects")
+	writeRoutes.Use(limiters.Write.RateLimitMiddleware())
+	{
+		// La importación asigna los proyectos al usuario del token
+		writeRoutes.POST("/import/csv",
+			auth.AuthMiddleware(os.Getenv("JWT_SECRET")),
+			controllers.IdentifyAdmin(os.Getenv("ADMIN_USER_IDS")),
+			importProjectsCSV.Execute,
+		)
+	}
+}
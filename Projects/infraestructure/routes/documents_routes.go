@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpDocumentsRoutes registra los documentos adjuntos de los proyectos
+func SetUpDocumentsRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	getProjectDocuments *controllers.GetProjectDocumentsController,
+	uploadProjectDocument *controllers.UploadProjectDocumentController,
+	downloadProjectDocument *controllers.DownloadProjectDocumentController,
+	deleteProjectDocument *controllers.DeleteProjectDocumentController,
+) {
+	readRoutes := r.Group("/projects")
+	readRoutes.Use(limiters.Read.RateLimitMiddleware())
+	{
+		readRoutes.GET("/:id/documents", getProjectDocuments.Execute)
+		readRoutes.GET("/:id/documents/:documentId", downloadProjectDocument.Execute)
+	}
+
+	writeRoutes := r.Group("/projects")
+	writeRoutes.Use(limiters.Write.RateLimitMiddleware())
+	{
+		// El documento registra como autor al usuario del token
+		writeRoutes.POST("/:id/documents", auth.AuthMiddleware(os.Getenv("JWT_SECRET")), uploadProjectDocument.Execute)
+		writeRoutes.DELETE("/:id/documents/:documentId", auth.AuthMiddleware(os.Getenv("JWT_SECRET")), deleteProjectDocument.Execute)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpGeocodingRoutes registra la geocodificación inversa bajo demanda de los proyectos
+func SetUpGeocodingRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	geocodeProject *controllers.GeocodeProjectController,
+) {
+	writeRoutes := r.Group("/projects")
+	writeRoutes.Use(limiters.Write.RateLimitMiddleware(), auth.AuthMiddleware(os.Getenv("JWT_SECRET")))
+	{
+		writeRoutes.POST("/:id/geocode", geocodeProject.Execute)
+	}
+}
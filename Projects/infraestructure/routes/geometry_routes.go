@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpGeometryRoutes registra el área levantada (polígono o línea) de los proyectos
+func SetUpGeometryRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	setGeometry *controllers.SetProjectGeometryController,
+	deleteGeometry *controllers.DeleteProjectGeometryController,
+) {
+	// El autor del cambio se toma del token
+	writeRoutes := r.Group("/projects")
+	writeRoutes.Use(
+		limiters.Write.RateLimitMiddleware(),
+		auth.AuthMiddleware(os.Getenv("JWT_SECRET")),
+	)
+	{
+		writeRoutes.PUT("/:id/geometry", setGeometry.Execute)
+		writeRoutes.DELETE("/:id/geometry", deleteGeometry.Execute)
+	}
+}
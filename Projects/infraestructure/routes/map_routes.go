@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpMapRoutes registra la agregación de proyectos para las vistas de mapa
+func SetUpMapRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	getProjectClusters *controllers.GetProjectClustersController,
+) {
+	queryRoutes := r.Group("/projects")
+	queryRoutes.Use(limiters.Query.RateLimitMiddleware())
+	{
+		queryRoutes.GET("/clusters", getProjectClusters.Execute)
+	}
+}
@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpMeasurementsRoutes registra los puntos de medición de los proyectos
+func SetUpMeasurementsRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	getMeasurements *controllers.GetMeasurementsController,
+	addMeasurements *controllers.AddMeasurementsController,
+	updateMeasurement *controllers.UpdateMeasurementController,
+	deleteMeasurement *controllers.DeleteMeasurementController,
+) {
+	readRoutes := r.Group("/projects")
+	readRoutes.Use(limiters.Read.RateLimitMiddleware())
+	{
+		readRoutes.GET("/:id/measurements", getMeasurements.Execute)
+	}
+
+	writeRoutes := r.Group("/projects")
+	writeRoutes.Use(limiters.Write.RateLimitMiddleware(), auth.AuthMiddleware(os.Getenv("JWT_SECRET")))
+	{
+		writeRoutes.POST("/:id/measurements", addMeasurements.Execute)
+		writeRoutes.PUT("/:id/measurements/:measurementId", updateMeasurement.Execute)
+		writeRoutes.DELETE("/:id/measurements/:measurementId", deleteMeasurement.Execute)
+	}
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpMediaRoutes registra la galería de imágenes de los proyectos. Los cambios requieren un token
+// válido porque un cambio de portada queda en el historial del proyecto a nombre del usuario
+func SetUpMediaRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	getProjectMedia *controllers.GetProjectMediaController,
+	uploadProjectMedia *controllers.UploadProjectMediaController,
+	reorderProjectMedia *controllers.ReorderProjectMediaController,
+	setProjectCover *controllers.SetProjectCoverController,
+	deleteProjectMedia *controllers.DeleteProjectMediaController,
+) {
+	readRoutes := r.Group("/projects")
+	readRoutes.Use(limiters.Read.RateLimitMiddleware())
+	{
+		readRoutes.GET("/:id/media", getProjectMedia.Execute)
+	}
+
+	writeRoutes := r.Group("/projects")
+	writeRoutes.Use(limiters.Write.RateLimitMiddleware(), auth.AuthMiddleware(os.Getenv("JWT_SECRET")))
+	{
+		writeRoutes.POST("/:id/media", uploadProjectMedia.Execute)
+		writeRoutes.PUT("/:id/media/order", reorderProjectMedia.Execute)
+		writeRoutes.PUT("/:id/media/:mediaId/cover", setProjectCover.Execute)
+		writeRoutes.DELETE("/:id/media/:mediaId", deleteProjectMedia.Execute)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"golang.org/x/time/rate"
 	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
 )
 
 // limiterEntry almacena un rate limiter con su timestamp de último uso
@@ -145,7 +146,8 @@ func getEnvDuration(key string, defaultVal time.Duration) time.Duration {
 	return defaultVal
 }
 
-func SetUpProjectsRoutes(r *gin.Engine, 
+func SetUpProjectsRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
 	createProjectController *controllers.CreateProjectController,
 	getProjectsController *controllers.GetAllProjectsController,
 	getProjectByIdController *controllers.GetProjectByIdController,
@@ -157,52 +159,68 @@ func SetUpProjectsRoutes(r *gin.Engine,
 	deleteProjectController *controllers.DeleteProjectController,
 	getProjectByUserId *controllers.GetProjectsByUserIdController,
 	getTotalProjectsByUser *controllers.GetTotalProjectsByUserController,
+	searchProjects *controllers.SearchProjectsController,
+	getProjectsNear *controllers.GetProjectsNearController,
+	getProjectsWithin *controllers.GetProjectsWithinController,
+	getNearestProjects *controllers.GetNearestProjectsController,
+	exportProjectsGeoJSON *controllers.ExportProjectsGeoJSONController,
+	importProjectsGeoJSON *controllers.ImportProjectsGeoJSONController,
+	exportProjectsKML *controllers.ExportProjectsKMLController,
+	exportProjectsGPX *controllers.ExportProjectsGPXController,
+	getProjectHistory *controllers.GetProjectHistoryController,
+	diffProjectRevisions *controllers.DiffProjectRevisionsController,
+	restoreProjectRevision *controllers.RestoreProjectRevisionController,
+	patchProject *controllers.PatchProjectController,
+	exportProjectsSpreadsheet *controllers.ExportProjectsSpreadsheetController,
 ) {
 
-	writeLimiter := NewRateLimiter(RateLimiterConfig{
-		RequestsPerSecond: getEnvFloat("PROJECTS_WRITE_RATE_LIMIT", 5),
-		Burst:             getEnvInt("PROJECTS_WRITE_BURST_LIMIT", 10),
-		TTL:               getEnvDuration("PROJECTS_RATE_LIMIT_TTL", 10*time.Minute),
-		CleanupInterval:   getEnvDuration("PROJECTS_RATE_LIMIT_CLEANUP", 5*time.Minute),
-	})
-	
-	readLimiter := NewRateLimiter(RateLimiterConfig{
-		RequestsPerSecond: getEnvFloat("PROJECTS_READ_RATE_LIMIT", 15),
-		Burst:             getEnvInt("PROJECTS_READ_BURST_LIMIT", 30),
-		TTL:               getEnvDuration("PROJECTS_RATE_LIMIT_TTL", 10*time.Minute),
-		CleanupInterval:   getEnvDuration("PROJECTS_RATE_LIMIT_CLEANUP", 5*time.Minute),
-	})
-	
-	queryLimiter := NewRateLimiter(RateLimiterConfig{
-		RequestsPerSecond: getEnvFloat("PROJECTS_QUERY_RATE_LIMIT", 8),
-		Burst:             getEnvInt("PROJECTS_QUERY_BURST_LIMIT", 15),
-		TTL:               getEnvDuration("PROJECTS_RATE_LIMIT_TTL", 10*time.Minute),
-		CleanupInterval:   getEnvDuration("PROJECTS_RATE_LIMIT_CLEANUP", 5*time.Minute),
-	})
-
 	writeRoutes := r.Group("/projects")
-	writeRoutes.Use(writeLimiter.RateLimitMiddleware())
+	writeRoutes.Use(limiters.Write.RateLimitMiddleware())
 	{
 		writeRoutes.POST("", createProjectController.Execute)
-		writeRoutes.PUT("/:id", updateProjectController.Execute)
+		// Las ediciones registran en el historial al usuario del token como autor del cambio; solo el
+		// dueño o un administrador pueden hacerlas
+		writeRoutes.PUT("/:id", auth.AuthMiddleware(os.Getenv("JWT_SECRET")), controllers.IdentifyAdmin(os.Getenv("ADMIN_USER_IDS")), updateProjectController.Execute)
+		writeRoutes.PATCH("/:id", auth.AuthMiddleware(os.Getenv("JWT_SECRET")), controllers.IdentifyAdmin(os.Getenv("ADMIN_USER_IDS")), patchProject.Execute)
 		writeRoutes.DELETE("/:id", deleteProjectController.Execute)
+		// La importación asigna los proyectos al usuario del token
+		writeRoutes.POST("/import",
+			auth.AuthMiddleware(os.Getenv("JWT_SECRET")),
+			controllers.IdentifyAdmin(os.Getenv("ADMIN_USER_IDS")),
+			importProjectsGeoJSON.Execute,
+		)
+		writeRoutes.POST("/:id/history/:revision/restore", auth.AuthMiddleware(os.Getenv("JWT_SECRET")), controllers.IdentifyAdmin(os.Getenv("ADMIN_USER_IDS")), restoreProjectRevision.Execute)
 	}
 
 	readRoutes := r.Group("/projects")
-	readRoutes.Use(readLimiter.RateLimitMiddleware())
+	readRoutes.Use(limiters.Read.RateLimitMiddleware())
 	{
 		readRoutes.GET("", getProjectsController.Execute)
 		readRoutes.GET("/id/:id", getProjectByIdController.Execute)
 		readRoutes.GET("/user/:userId", getProjectByUserId.Execute)
+		readRoutes.GET("/:id/history", getProjectHistory.Execute)
+		readRoutes.GET("/:id/history/diff", diffProjectRevisions.Execute)
 	}
 
 	queryRoutes := r.Group("/projects")
-	queryRoutes.Use(queryLimiter.RateLimitMiddleware())
+	queryRoutes.Use(limiters.Query.RateLimitMiddleware())
 	{
 		queryRoutes.GET("/nombre/:nombre", getProjectByNameController.Execute)
 		queryRoutes.GET("/categoria/:categoria", getProjectByCategoryController.Execute)
 		queryRoutes.GET("/fecha/:fecha", getProjectByDateController.Execute)
 		queryRoutes.GET("/stats", getProjectsStats.Execute)
 		queryRoutes.GET("/total/user/:userId", getTotalProjectsByUser.Execute)
+		queryRoutes.GET("/search", searchProjects.Execute)
+		queryRoutes.GET("/near", getProjectsNear.Execute)
+		queryRoutes.GET("/within", getProjectsWithin.Execute)
+		queryRoutes.GET("/nearest", getNearestProjects.Execute)
 	}
+
+	// Las exportaciones usan la extensión en la ruta, fuera del grupo /projects
+	r.GET("/projects.geojson", limiters.Query.RateLimitMiddleware(), exportProjectsGeoJSON.Execute)
+	r.GET("/projects.kml", limiters.Query.RateLimitMiddleware(), exportProjectsKML.Execute)
+	r.GET("/projects.kmz", limiters.Query.RateLimitMiddleware(), exportProjectsKML.ExecuteKMZ)
+	r.GET("/projects.gpx", limiters.Query.RateLimitMiddleware(), exportProjectsGPX.Execute)
+	r.GET("/projects.csv", limiters.Query.RateLimitMiddleware(), exportProjectsSpreadsheet.Execute)
+	r.GET("/projects.xlsx", limiters.Query.RateLimitMiddleware(), exportProjectsSpreadsheet.ExecuteXLSX)
 }
\ No newline at end of file
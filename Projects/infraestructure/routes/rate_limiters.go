@@ -0,0 +1,39 @@
+package routes
+
+import "time"
+
+// ProjectLimiters son los limitadores que comparten todas las rutas de proyectos. Se crean una sola
+// vez para que cada cliente tenga un único presupuesto de lecturas, escrituras y consultas sin importar
+// a qué grupo de rutas llame
+type ProjectLimiters struct {
+	Read  *RateLimiter
+	Write *RateLimiter
+	Query *RateLimiter
+}
+
+// NewProjectLimiters crea los limitadores con PROJECTS_READ_*, PROJECTS_WRITE_* y PROJECTS_QUERY_*
+func NewProjectLimiters() *ProjectLimiters {
+	ttl := getEnvDuration("PROJECTS_RATE_LIMIT_TTL", 10*time.Minute)
+	cleanup := getEnvDuration("PROJECTS_RATE_LIMIT_CLEANUP", 5*time.Minute)
+
+	return &ProjectLimiters{
+		Read: NewRateLimiter(RateLimiterConfig{
+			RequestsPerSecond: getEnvFloat("PROJECTS_READ_RATE_LIMIT", 15),
+			Burst:             getEnvInt("PROJECTS_READ_BURST_LIMIT", 30),
+			TTL:               ttl,
+			CleanupInterval:   cleanup,
+		}),
+		Write: NewRateLimiter(RateLimiterConfig{
+			RequestsPerSecond: getEnvFloat("PROJECTS_WRITE_RATE_LIMIT", 5),
+			Burst:             getEnvInt("PROJECTS_WRITE_BURST_LIMIT", 10),
+			TTL:               ttl,
+			CleanupInterval:   cleanup,
+		}),
+		Query: NewRateLimiter(RateLimiterConfig{
+			RequestsPerSecond: getEnvFloat("PROJECTS_QUERY_RATE_LIMIT", 8),
+			Burst:             getEnvInt("PROJECTS_QUERY_BURST_LIMIT", 15),
+			TTL:               ttl,
+			CleanupInterval:   cleanup,
+		}),
+	}
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpReportRoutes registra el reporte PDF de entrega de los proyectos
+func SetUpReportRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	generateProjectReport *controllers.GenerateProjectReportController,
+) {
+	readRoutes := r.Group("/projects")
+	readRoutes.Use(limiters.Read.RateLimitMiddleware())
+	{
+		readRoutes.GET("/:id/report.pdf", generateProjectReport.Execute)
+	}
+}
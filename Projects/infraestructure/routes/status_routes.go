@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpStatusRoutes registra el ciclo de vida (estados y transiciones) de los proyectos
+func SetUpStatusRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	getWorkflow *controllers.GetProjectStatusWorkflowController,
+	transitionStatus *controllers.TransitionProjectStatusController,
+	getStatusHistory *controllers.GetProjectStatusHistoryController,
+) {
+	readRoutes := r.Group("/projects")
+	readRoutes.Use(limiters.Read.RateLimitMiddleware())
+	{
+		readRoutes.GET("/statuses", getWorkflow.Execute)
+		readRoutes.GET("/:id/status/history", getStatusHistory.Execute)
+	}
+
+	// El autor del cambio se toma del token
+	writeRoutes := r.Group("/projects")
+	writeRoutes.Use(
+		limiters.Write.RateLimitMiddleware(),
+		auth.AuthMiddleware(os.Getenv("JWT_SECRET")),
+	)
+	{
+		writeRoutes.POST("/:id/status", transitionStatus.Execute)
+	}
+}
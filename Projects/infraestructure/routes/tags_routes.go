@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpTagsRoutes registra las etiquetas de los proyectos y el autocompletado
+func SetUpTagsRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	getProjectTags *controllers.GetProjectTagsController,
+	addProjectTags *controllers.AddProjectTagsController,
+	removeProjectTag *controllers.RemoveProjectTagController,
+	autocompleteTags *controllers.AutocompleteTagsController,
+) {
+	readRoutes := r.Group("")
+	readRoutes.Use(limiters.Read.RateLimitMiddleware())
+	{
+		readRoutes.GET("/tags", autocompleteTags.Execute)
+		readRoutes.GET("/projects/:id/tags", getProjectTags.Execute)
+	}
+
+	writeRoutes := r.Group("/projects")
+	writeRoutes.Use(limiters.Write.RateLimitMiddleware(), auth.AuthMiddleware(os.Getenv("JWT_SECRET")))
+	{
+		writeRoutes.POST("/:id/tags", addProjectTags.Execute)
+		writeRoutes.DELETE("/:id/tags/:tag", removeProjectTag.Execute)
+	}
+}
@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/infraestructure/controllers"
+	auth "github.com/JosephAntony37900/Geova-back-1/Users/infraestructure/services"
+	"github.com/gin-gonic/gin"
+)
+
+// SetUpTemplatesRoutes registra las plantillas de proyecto y la clonación de proyectos
+func SetUpTemplatesRoutes(r *gin.Engine,
+	limiters *ProjectLimiters,
+	getTemplates *controllers.GetProjectTemplatesController,
+	getTemplate *controllers.GetProjectTemplateController,
+	createTemplate *controllers.CreateProjectTemplateController,
+	updateTemplate *controllers.UpdateProjectTemplateController,
+	deleteTemplate *controllers.DeleteProjectTemplateController,
+	cloneProject *controllers.CloneProjectController,
+) {
+	readRoutes := r.Group("/projects")
+	readRoutes.Use(limiters.Read.RateLimitMiddleware())
+	{
+		readRoutes.GET("/templates", getTemplates.Execute)
+		readRoutes.GET("/templates/:templateId", getTemplate.Execute)
+	}
+
+	writeRoutes := r.Group("/projects")
+	writeRoutes.Use(limiters.Write.RateLimitMiddleware())
+	{
+		// La plantilla y la copia de un proyecto pertenecen al usuario del token; solo el autor de la
+		// plantilla o un administrador la modifican o la eliminan
+		writeRoutes.POST("/templates", auth.AuthMiddleware(os.Getenv("JWT_SECRET")), createTemplate.Execute)
+		writeRoutes.PUT("/templates/:templateId", auth.AuthMiddleware(os.Getenv("JWT_SECRET")), controllers.IdentifyAdmin(os.Getenv("ADMIN_USER_IDS")), updateTemplate.Execute)
+		writeRoutes.DELETE("/templates/:templateId", auth.AuthMiddleware(os.Getenv("JWT_SECRET")), controllers.IdentifyAdmin(os.Getenv("ADMIN_USER_IDS")), deleteTemplate.Execute)
+		writeRoutes.POST("/:id/clone", auth.AuthMiddleware(os.Getenv("JWT_SECRET")), cloneProject.Execute)
+	}
+}
@@ -0,0 +1,93 @@
+package adapters
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// geocodeCacheKey son las coordenadas redondeadas a 4 decimales (unos 11 m), suficiente para que los
+// proyectos de un mismo sitio compartan la consulta
+type geocodeCacheKey struct {
+	lat, lng int64
+}
+
+type geocodeCacheEntry struct {
+	address   *entities.ProjectAddress
+	notFound  bool
+	expiresAt time.Time
+}
+
+// CachedGeocoder guarda en memoria las respuestas de otro geocodificador durante ttl, incluidos los puntos
+// sin división administrativa. Al superar maxEntries descarta primero las entradas vencidas y, si no
+// alcanza, vacía la caché
+type CachedGeocoder struct {
+	next       services.IGeocoder
+	ttl        time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[geocodeCacheKey]geocodeCacheEntry
+}
+
+func NewCachedGeocoder(next services.IGeocoder, ttl time.Duration, maxEntries int) *CachedGeocoder {
+	return &CachedGeocoder{
+		next:       next,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[geocodeCacheKey]geocodeCacheEntry),
+	}
+}
+
+func (c *CachedGeocoder) ReverseGeocode(lat, lng float64) (*entities.ProjectAddress, error) {
+	key := geocodeCacheKey{int64(math.Round(lat * 1e4)), int64(math.Round(lng * 1e4))}
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		if entry.notFound {
+			return nil, entities.ErrNotFound
+		}
+		address := *entry.address
+		return &address, nil
+	}
+
+	address, err := c.next.ReverseGeocode(lat, lng)
+	notFound := errors.Is(err, entities.ErrNotFound)
+	if err != nil && !notFound {
+		// Los fallos transitorios no se guardan para poder reintentar
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+	entry = geocodeCacheEntry{notFound: notFound, expiresAt: now.Add(c.ttl)}
+	if address != nil {
+		cached := *address
+		entry.address = &cached
+	}
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	return address, err
+}
+
+// evict libera espacio en la caché; se llama con mu tomado
+func (c *CachedGeocoder) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) >= c.maxEntries {
+		c.entries = make(map[geocodeCacheKey]geocodeCacheEntry)
+	}
+}
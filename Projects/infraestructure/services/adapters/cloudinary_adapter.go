@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path"
+	"regexp"
+	"strings"
 
 	"github.com/cloudinary/cloudinary-go/v2"
 	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
@@ -34,3 +37,28 @@ func (c *CloudinaryAdapter) UploadImage(localPath string) (string, error) {
 	}
 	return uploadResult.SecureURL, nil
 }
+
+// cloudinaryVersion es el segmento de versión ("v1712345678/") que Cloudinary antepone al public id
+var cloudinaryVersion = regexp.MustCompile(`^v[0-9]+/`)
+
+// cloudinaryPublicId obtiene el public id de una imagen a partir de su URL de entrega:
+// https://res.cloudinary.com/<cloud>/image/upload/v123/carpeta/nombre.jpg -> carpeta/nombre
+func cloudinaryPublicId(url string) (string, error) {
+	_, rest, found := strings.Cut(url, "/upload/")
+	if !found || rest == "" {
+		return "", fmt.Errorf("la URL %q no es de una imagen de cloudinary", url)
+	}
+	rest = cloudinaryVersion.ReplaceAllString(rest, "")
+	return strings.TrimSuffix(rest, path.Ext(rest)), nil
+}
+
+func (c *CloudinaryAdapter) DeleteImage(url string) error {
+	publicId, err := cloudinaryPublicId(url)
+	if err != nil {
+		return err
+	}
+	if _, err := c.cld.Upload.Destroy(context.Background(), uploader.DestroyParams{PublicID: publicId}); err != nil {
+		return fmt.Errorf("error al eliminar imagen de cloudinary: %w", err)
+	}
+	return nil
+}
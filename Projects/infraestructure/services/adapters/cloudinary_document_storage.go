@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cloudinary/cloudinary-go/v2/api"
+	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+)
+
+// CloudinaryDocumentStorage guarda los adjuntos como recursos raw de Cloudinary; la ubicación es el public_id
+type CloudinaryDocumentStorage struct {
+	adapter *CloudinaryAdapter
+	client  *http.Client
+}
+
+func NewCloudinaryDocumentStorage(adapter *CloudinaryAdapter) *CloudinaryDocumentStorage {
+	return &CloudinaryDocumentStorage{
+		adapter: adapter,
+		client:  &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+func (s *CloudinaryDocumentStorage) Save(key string, content io.Reader, size int64, contentType string) (string, error) {
+	result, err := s.adapter.cld.Upload.Upload(context.Background(), content, uploader.UploadParams{
+		PublicID:     key,
+		ResourceType: api.File,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error al subir documento a cloudinary: %w", err)
+	}
+	if result.Error.Message != "" {
+		return "", fmt.Errorf("error al subir documento a cloudinary: %s", result.Error.Message)
+	}
+	return result.PublicID, nil
+}
+
+func (s *CloudinaryDocumentStorage) Open(location string) (io.ReadCloser, error) {
+	file, err := s.adapter.cld.File(location)
+	if err != nil {
+		return nil, fmt.Errorf("error al construir la URL del documento: %w", err)
+	}
+	file.Config.URL.Secure = true
+	url, err := file.String()
+	if err != nil {
+		return nil, fmt.Errorf("error al construir la URL del documento: %w", err)
+	}
+
+	resp, err := s.client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("error al descargar documento de cloudinary: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("cloudinary respondió %d al descargar el documento", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+func (s *CloudinaryDocumentStorage) Delete(location string) error {
+	_, err := s.adapter.cld.Upload.Destroy(context.Background(), uploader.DestroyParams{
+		PublicID:     location,
+		ResourceType: api.File,
+	})
+	if err != nil {
+		return fmt.Errorf("error al eliminar documento de cloudinary: %w", err)
+	}
+	return nil
+}
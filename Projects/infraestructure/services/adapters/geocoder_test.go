@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// countingGeocoder cuenta las consultas que llegan al geocodificador envuelto por la caché
+type countingGeocoder struct {
+	calls int
+}
+
+func (g *countingGeocoder) ReverseGeocode(lat, lng float64) (*entities.ProjectAddress, error) {
+	g.calls++
+	if lat < 0 {
+		return nil, entities.ErrNotFound
+	}
+	return &entities.ProjectAddress{Municipality: "Cuauhtémoc", CountryCode: "MX"}, nil
+}
+
+func TestReverseGeocoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/reverse" || query.Get("format") != "jsonv2" || r.Header.Get("User-Agent") != "geova-test" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if query.Get("lat") == "0.5" {
+			w.Write([]byte(`{"error":"Unable to geocode"}`))
+			return
+		}
+		w.Write([]byte(`{"address":{"city":"Ciudad de México","county":"Cuauhtémoc","state":"Ciudad de México","country":"México","country_code":"mx"}}`))
+	}))
+	defer server.Close()
+
+	nominatim := NewNominatimGeocoder(server.URL+"/", "geova-test", 0)
+	address, err := nominatim.ReverseGeocode(19.4326, -99.1332)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if address.Municipality != "Cuauhtémoc" || address.State != "Ciudad de México" || address.CountryCode != "MX" {
+		t.Errorf("dirección inesperada: %+v", address)
+	}
+	if _, err := nominatim.ReverseGeocode(0.5, -140); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("se esperaba ErrNotFound en el mar, obtenido %v", err)
+	}
+
+	// Un estado con un municipio que tiene un hueco (otro municipio enclavado)
+	path := filepath.Join(t.TempDir(), "limites.geojson")
+	boundaries := `{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{"state":"Jalisco","country":"México","country_code":"mx"},
+		 "geometry":{"type":"MultiPolygon","coordinates":[[[[-105,19],[-102,19],[-102,22],[-105,22],[-105,19]]]]}},
+		{"type":"Feature","properties":{"municipality":"Guadalajara"},
+		 "geometry":{"type":"Polygon","coordinates":[[[-103.5,20.5],[-103.2,20.5],[-103.2,20.8],[-103.5,20.8],[-103.5,20.5]],
+		                                             [[-103.4,20.6],[-103.3,20.6],[-103.3,20.7],[-103.4,20.7],[-103.4,20.6]]]}}]}`
+	if err := os.WriteFile(path, []byte(boundaries), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	offline, err := NewOfflineGeocoder(path)
+	if err != nil {
+		t.Fatalf("error al cargar los límites: %v", err)
+	}
+	address, err = offline.ReverseGeocode(20.55, -103.45)
+	if err != nil || address.Municipality != "Guadalajara" || address.State != "Jalisco" || address.CountryCode != "MX" {
+		t.Errorf("dirección inesperada: %+v (%v)", address, err)
+	}
+	if address, err = offline.ReverseGeocode(20.65, -103.35); err != nil || address.Municipality != "" || address.State != "Jalisco" {
+		t.Errorf("el hueco no pertenece al municipio: %+v (%v)", address, err)
+	}
+	if _, err := offline.ReverseGeocode(40, -3); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("se esperaba ErrNotFound fuera de los límites, obtenido %v", err)
+	}
+
+	next := &countingGeocoder{}
+	cached := NewCachedGeocoder(next, time.Hour, 100)
+	cached.ReverseGeocode(19.43261, -99.13321)
+	cached.ReverseGeocode(19.43259, -99.13319)
+	if _, err := cached.ReverseGeocode(-30, 0); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("se esperaba ErrNotFound, obtenido %v", err)
+	}
+	cached.ReverseGeocode(-30, 0)
+	if next.calls != 2 {
+		t.Errorf("se esperaban 2 consultas al geocodificador, obtenidas %d", next.calls)
+	}
+}
+
+// TestDefaultBoundaries comprueba que el archivo de límites incluido en el repositorio, que se usa cuando
+// no se configura GEOCODER, carga y ubica correctamente puntos dentro y fuera de México
+func TestNominatimThrottle(t *testing.T) {
+	interval := 40 * time.Millisecond
+	geocoder := NewNominatimGeocoder("http://localhost", "geova-test", interval)
+
+	// Tres peticiones simultáneas reservan turnos consecutivos separados por el intervalo
+	start := time.Now()
+	done := make(chan struct{})
+	for i := 0; i < 3; i++ {
+		go func() {
+			geocoder.wait()
+			done <- struct{}{}
+		}()
+	}
+	for i := 0; i < 3; i++ {
+		<-done
+	}
+	if elapsed := time.Since(start); elapsed < 2*interval {
+		t.Errorf("las peticiones no respetaron el intervalo: %v", elapsed)
+	}
+}
@@ -0,0 +1,44 @@
+package adapters
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxLoadedImageBytes limita el tamaño de cada imagen descargada
+const maxLoadedImageBytes = 15 << 20
+
+// HTTPImageLoader descarga imágenes por HTTP(S), como las de la galería guardadas en Cloudinary
+type HTTPImageLoader struct {
+	client *http.Client
+}
+
+func NewHTTPImageLoader(timeout time.Duration) *HTTPImageLoader {
+	return &HTTPImageLoader{client: &http.Client{Timeout: timeout}}
+}
+
+func (l *HTTPImageLoader) Load(url string) ([]byte, error) {
+	if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+		return nil, fmt.Errorf("la URL de la imagen debe ser http o https")
+	}
+	resp, err := l.client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("error al descargar la imagen: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("la descarga de la imagen respondió %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxLoadedImageBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("error al descargar la imagen: %w", err)
+	}
+	if len(data) > maxLoadedImageBytes {
+		return nil, fmt.Errorf("la imagen supera los %d MB", maxLoadedImageBytes>>20)
+	}
+	return data, nil
+}
@@ -0,0 +1,83 @@
+package adapters
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalDocumentStorage guarda los adjuntos en un directorio del servidor (o en un volumen montado,
+// por ejemplo un bucket S3 compatible); la ubicación es la ruta relativa al directorio base
+type LocalDocumentStorage struct {
+	baseDir string
+}
+
+func NewLocalDocumentStorage(baseDir string) *LocalDocumentStorage {
+	return &LocalDocumentStorage{baseDir: baseDir}
+}
+
+// path resuelve una ubicación dentro del directorio base y rechaza las que intenten salir de él
+func (s *LocalDocumentStorage) path(location string) (string, error) {
+	path := filepath.Join(s.baseDir, filepath.FromSlash(location))
+	rel, err := filepath.Rel(s.baseDir, path)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("ubicación de documento inválida: %q", location)
+	}
+	return path, nil
+}
+
+func (s *LocalDocumentStorage) Save(key string, content io.Reader, size int64, contentType string) (string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("error al crear el directorio de documentos: %w", err)
+	}
+
+	// Se escribe en un temporal y se renombra para no dejar archivos a medias
+	tmp := path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return "", fmt.Errorf("error al crear el documento: %w", err)
+	}
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+		os.Remove(tmp)
+		return "", fmt.Errorf("error al escribir el documento: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(tmp)
+		return "", fmt.Errorf("error al escribir el documento: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return "", fmt.Errorf("error al guardar el documento: %w", err)
+	}
+	return key, nil
+}
+
+func (s *LocalDocumentStorage) Open(location string) (io.ReadCloser, error) {
+	path, err := s.path(location)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error al abrir el documento: %w", err)
+	}
+	return file, nil
+}
+
+func (s *LocalDocumentStorage) Delete(location string) error {
+	path, err := s.path(location)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error al eliminar el documento: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package adapters
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLocalDocumentStorage(t *testing.T) {
+	storage := NewLocalDocumentStorage(t.TempDir())
+
+	location, err := storage.Save("projects/4/abc.csv", strings.NewReader("id,lat\n1,19.4"), 14, "text/csv")
+	if err != nil {
+		t.Fatalf("error al guardar: %v", err)
+	}
+	content, err := storage.Open(location)
+	if err != nil {
+		t.Fatalf("error al abrir: %v", err)
+	}
+	data, _ := io.ReadAll(content)
+	content.Close()
+	if string(data) != "id,lat\n1,19.4" {
+		t.Errorf("contenido inesperado: %q", data)
+	}
+
+	if _, err := storage.Open("../../etc/passwd"); err == nil {
+		t.Error("se esperaba rechazar una ubicación fuera del directorio base")
+	}
+	if err := storage.Delete(location); err != nil {
+		t.Errorf("error al eliminar: %v", err)
+	}
+	if _, err := storage.Open(location); err == nil {
+		t.Error("el documento debería haberse eliminado")
+	}
+}
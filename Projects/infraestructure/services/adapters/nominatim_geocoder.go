@@ -0,0 +1,108 @@
+package adapters
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+)
+
+// NominatimGeocoder consulta el endpoint /reverse de un servidor compatible con Nominatim (el público de
+// OpenStreetMap o uno propio). Espera al menos minInterval entre peticiones para respetar la política
+// de uso del servicio público (1 petición por segundo)
+type NominatimGeocoder struct {
+	baseURL     string
+	userAgent   string
+	minInterval time.Duration
+	client      *http.Client
+
+	mu   sync.Mutex
+	last time.Time // turno reservado por la última petición
+}
+
+func NewNominatimGeocoder(baseURL, userAgent string, minInterval time.Duration) *NominatimGeocoder {
+	return &NominatimGeocoder{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		userAgent:   userAgent,
+		minInterval: minInterval,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// nominatimResponse es la parte de la respuesta jsonv2 que se usa
+type nominatimResponse struct {
+	Error   string            `json:"error"`
+	Address map[string]string `json:"address"`
+}
+
+// municipalityKeys son las claves de Nominatim que pueden contener el municipio, en orden de preferencia
+var municipalityKeys = []string{"municipality", "county", "city", "town", "village"}
+
+func (g *NominatimGeocoder) ReverseGeocode(lat, lng float64) (*entities.ProjectAddress, error) {
+	query := url.Values{}
+	query.Set("format", "jsonv2")
+	query.Set("lat", strconv.FormatFloat(lat, 'f', -1, 64))
+	query.Set("lon", strconv.FormatFloat(lng, 'f', -1, 64))
+	query.Set("zoom", "10")
+	query.Set("addressdetails", "1")
+	query.Set("accept-language", "es")
+
+	req, err := http.NewRequest(http.MethodGet, g.baseURL+"/reverse?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error al crear la petición de geocodificación: %w", err)
+	}
+	req.Header.Set("User-Agent", g.userAgent)
+
+	g.wait()
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar el geocodificador: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("el geocodificador respondió %d", resp.StatusCode)
+	}
+
+	var body nominatimResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("respuesta inválida del geocodificador: %w", err)
+	}
+	// Nominatim responde 200 con un campo error cuando el punto no está en ninguna división (p. ej. en el mar)
+	if body.Error != "" {
+		return nil, fmt.Errorf("%w: %s", entities.ErrNotFound, body.Error)
+	}
+
+	address := &entities.ProjectAddress{
+		State:       body.Address["state"],
+		Country:     body.Address["country"],
+		CountryCode: strings.ToUpper(body.Address["country_code"]),
+	}
+	for _, key := range municipalityKeys {
+		if value := body.Address[key]; value != "" {
+			address.Municipality = value
+			break
+		}
+	}
+	return address, nil
+}
+
+// wait bloquea hasta el turno de esta petición, minInterval después del turno anterior. El turno se
+// reserva con el candado tomado, pero la espera ocurre sin él para no bloquear a quien solo reserva
+func (g *NominatimGeocoder) wait() {
+	g.mu.Lock()
+	slot := g.last.Add(g.minInterval)
+	if now := time.Now(); slot.Before(now) {
+		slot = now
+	}
+	g.last = slot
+	g.mu.Unlock()
+
+	time.Sleep(time.Until(slot))
+}
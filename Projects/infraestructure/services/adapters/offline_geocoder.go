@@ -0,0 +1,146 @@
+package adapters
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/entities"
+	"github.com/JosephAntony37900/Geova-back-1/Projects/domain/services"
+)
+
+// boundary es una división administrativa del archivo de límites: sus polígonos (cada uno con el anillo
+// exterior primero y luego los huecos) y la caja que los contiene para descartar puntos rápidamente
+type boundary struct {
+	address  entities.ProjectAddress
+	polygons [][][]entities.Position
+	bbox     entities.BoundingBox
+}
+
+// OfflineGeocoder resuelve la dirección sin salir a internet a partir de un FeatureCollection de límites
+// administrativos (Polygon o MultiPolygon) con las propiedades municipality, state, country y
+// country_code. Un punto puede caer en varias divisiones (municipio, estado y país en archivos
+// separados o en el mismo); los campos se combinan y gana la primera división que los define
+type OfflineGeocoder struct {
+	boundaries []boundary
+}
+
+// NewOfflineGeocoder carga el archivo de límites en memoria
+func NewOfflineGeocoder(path string) (*OfflineGeocoder, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error al leer el archivo de límites administrativos: %w", err)
+	}
+
+	var collection struct {
+		Features []struct {
+			Geometry   entities.GeoJSONGeometry `json:"geometry"`
+			Properties map[string]interface{}   `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, fmt.Errorf("archivo de límites administrativos inválido: %w", err)
+	}
+
+	geocoder := &OfflineGeocoder{}
+	for i, feature := range collection.Features {
+		var polygons [][][]entities.Position
+		switch feature.Geometry.Type {
+		case "Polygon":
+			var polygon [][]entities.Position
+			err = json.Unmarshal(feature.Geometry.Coordinates, &polygon)
+			polygons = [][][]entities.Position{polygon}
+		case "MultiPolygon":
+			err = json.Unmarshal(feature.Geometry.Coordinates, &polygons)
+		default:
+			// Los puntos o líneas del archivo no delimitan ninguna división
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("geometría inválida en el límite %d: %w", i, err)
+		}
+
+		b := boundary{
+			address: entities.ProjectAddress{
+				Municipality: stringProperty(feature.Properties, "municipality"),
+				State:        stringProperty(feature.Properties, "state"),
+				Country:      stringProperty(feature.Properties, "country"),
+				CountryCode:  strings.ToUpper(stringProperty(feature.Properties, "country_code")),
+			},
+			polygons: polygons,
+			bbox:     entities.BoundingBox{MinLat: 90, MinLng: 180, MaxLat: -90, MaxLng: -180},
+		}
+		for _, polygon := range polygons {
+			if len(polygon) == 0 {
+				continue
+			}
+			for _, position := range polygon[0] {
+				b.bbox.MinLng = min(b.bbox.MinLng, position[0])
+				b.bbox.MaxLng = max(b.bbox.MaxLng, position[0])
+				b.bbox.MinLat = min(b.bbox.MinLat, position[1])
+				b.bbox.MaxLat = max(b.bbox.MaxLat, position[1])
+			}
+		}
+		geocoder.boundaries = append(geocoder.boundaries, b)
+	}
+	return geocoder, nil
+}
+
+func (g *OfflineGeocoder) ReverseGeocode(lat, lng float64) (*entities.ProjectAddress, error) {
+	point := entities.Position{lng, lat}
+	var address entities.ProjectAddress
+	found := false
+
+	for _, b := range g.boundaries {
+		if lat < b.bbox.MinLat || lat > b.bbox.MaxLat || lng < b.bbox.MinLng || lng > b.bbox.MaxLng {
+			continue
+		}
+		if !containsPoint(b.polygons, point) {
+			continue
+		}
+		found = true
+		if address.Municipality == "" {
+			address.Municipality = b.address.Municipality
+		}
+		if address.State == "" {
+			address.State = b.address.State
+		}
+		if address.Country == "" {
+			address.Country = b.address.Country
+		}
+		if address.CountryCode == "" {
+			address.CountryCode = b.address.CountryCode
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%w: el punto (%g, %g) no está en ninguna división administrativa conocida", entities.ErrNotFound, lat, lng)
+	}
+	return &address, nil
+}
+
+// containsPoint indica si el punto está dentro de alguno de los polígonos y fuera de sus huecos
+func containsPoint(polygons [][][]entities.Position, point entities.Position) bool {
+	for _, polygon := range polygons {
+		if len(polygon) == 0 || !services.PointInRing(point, polygon[0]) {
+			continue
+		}
+		inHole := false
+		for _, hole := range polygon[1:] {
+			if services.PointInRing(point, hole) {
+				inHole = true
+				break
+			}
+		}
+		if !inHole {
+			return true
+		}
+	}
+	return false
+}
+
+func stringProperty(properties map[string]interface{}, key string) string {
+	value, _ := properties[key].(string)
+	return value
+}
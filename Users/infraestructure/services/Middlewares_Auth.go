@@ -40,7 +40,8 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 			return
 		}
 
-		c.Set("userID", claims["user_id"])
+		// JWTManager.GenerateToken emite el id del usuario en el claim userId
+		c.Set("userID", claims["userId"])
 		c.Next()
 	}
 }
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Búsqueda de texto completo sobre nombre y descripción de proyectos.
+-- La colación utf8mb4_0900_ai_ci hace que FULLTEXT ignore acentos y mayúsculas.
+
+ALTER TABLE projects
+    MODIFY NombreProyecto VARCHAR(200) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL,
+    MODIFY Descripcion TEXT CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci NOT NULL;
+
+ALTER TABLE projects
+    ADD FULLTEXT INDEX ft_projects_nombre (NombreProyecto),
+    ADD FULLTEXT INDEX ft_projects_nombre_descripcion (NombreProyecto, Descripcion);
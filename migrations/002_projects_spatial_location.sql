@@ -0,0 +1,25 @@
+-- Columna espacial para consultas por ubicación (cercanía, bbox, k vecinos).
+-- Ubicacion se genera a partir de Lat/Lng, por lo que no requiere cambios al insertar o actualizar.
+-- En SRID 4326 MySQL usa el orden de ejes latitud-longitud, de ahí POINT(Lat, Lng).
+
+-- Los registros con coordenadas nulas o fuera de rango impedirían generar la columna. No se modifican
+-- ni se eliminan: si existe alguno este paso falla con "Check constraint 'chk_projects_location_valid'
+-- is violated" y la columna no se agrega. Los proyectos afectados se consultan con
+--   SELECT Id, NombreProyecto, Lat, Lng FROM projects
+--   WHERE Lat IS NULL OR Lng IS NULL OR Lat NOT BETWEEN -90 AND 90 OR Lng NOT BETWEEN -180 AND 180;
+-- Corrija sus coordenadas y vuelva a ejecutar la migración
+CREATE TEMPORARY TABLE projects_location_check (
+    invalid_rows INT NOT NULL,
+    CONSTRAINT chk_projects_location_valid CHECK (invalid_rows = 0)
+);
+
+INSERT INTO projects_location_check
+SELECT COUNT(*) FROM projects
+WHERE Lat IS NULL OR Lng IS NULL OR Lat NOT BETWEEN -90 AND 90 OR Lng NOT BETWEEN -180 AND 180;
+
+DROP TEMPORARY TABLE projects_location_check;
+
+ALTER TABLE projects
+    ADD COLUMN Ubicacion POINT SRID 4326
+        AS (ST_SRID(POINT(Lat, Lng), 4326)) STORED NOT NULL,
+    ADD SPATIAL INDEX sp_projects_ubicacion (Ubicacion);
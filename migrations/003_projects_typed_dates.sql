@@ -0,0 +1,60 @@
+-- Fecha pasa de VARCHAR libre a DATETIME en UTC y se agregan created_at/updated_at,
+-- administrados por el servidor al crear y actualizar un proyecto.
+
+-- El modo estricto garantiza que la conversión falle en lugar de guardar fechas en cero
+SET SESSION sql_mode = 'ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,STRICT_ALL_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_ENGINE_SUBSTITUTION';
+
+-- El texto original de la fecha se conserva en fecha_original, que no usa la aplicación
+ALTER TABLE projects
+    ADD COLUMN fecha_original VARCHAR(255) NULL,
+    MODIFY COLUMN Fecha VARCHAR(255) NULL;
+
+UPDATE projects SET fecha_original = Fecha;
+
+-- Normaliza los valores existentes a 'AAAA-MM-DD HH:MM:SS' en UTC antes de cambiar el tipo, igual que
+-- ParseProjectDate: se conservan los segundos (las fracciones se descartan) y las fechas con zona
+-- horaria (Z, ±HH:MM o ±HHMM) se convierten a UTC; las que no la indican se toman como UTC.
+-- Los valores que no son ISO-8601 reconocible, o con un desplazamiento fuera de rango, quedan en NULL
+UPDATE projects
+SET Fecha = CASE
+    WHEN Fecha REGEXP '^[0-9]{4}-[0-9]{2}-[0-9]{2}$'
+        THEN CONCAT(Fecha, ' 00:00:00')
+    WHEN Fecha REGEXP '^[0-9]{4}-[0-9]{2}-[0-9]{2}[T ][0-9]{2}:[0-9]{2}(:[0-9]{2}([.,][0-9]+)?)?(Z|[+-][0-9]{2}:?[0-9]{2})?$'
+        THEN DATE_FORMAT(
+            CONVERT_TZ(
+                STR_TO_DATE(
+                    RPAD(REPLACE(REGEXP_SUBSTR(Fecha, '^[0-9]{4}-[0-9]{2}-[0-9]{2}[T ][0-9]{2}:[0-9]{2}(:[0-9]{2})?'), 'T', ' '), 19, ':00'),
+                    '%Y-%m-%d %H:%i:%s'
+                ),
+                COALESCE(
+                    CONCAT(
+                        LEFT(REGEXP_SUBSTR(Fecha, '[+-][0-9]{2}:?[0-9]{2}$'), 3),
+                        ':',
+                        RIGHT(REGEXP_SUBSTR(Fecha, '[+-][0-9]{2}:?[0-9]{2}$'), 2)
+                    ),
+                    '+00:00'
+                ),
+                '+00:00'
+            ),
+            '%Y-%m-%d %H:%i:%s'
+        )
+    ELSE NULL
+END;
+
+-- Si alguna fecha no se pudo interpretar este paso falla con "Invalid use of NULL value" (o con
+-- "Incorrect datetime value" para fechas imposibles como 2025-02-30) y la columna no se convierte.
+-- Los proyectos afectados se consultan con
+--   SELECT Id, fecha_original FROM projects WHERE Fecha IS NULL;
+-- Corrija su Fecha con el formato 'AAAA-MM-DD HH:MM:SS' y continúe la migración desde este paso
+ALTER TABLE projects
+    MODIFY COLUMN Fecha DATETIME NOT NULL,
+    ADD COLUMN created_at DATETIME(6) NULL,
+    ADD COLUMN updated_at DATETIME(6) NULL;
+
+-- Los proyectos existentes no registraban cuándo se crearon ni modificaron: created_at y updated_at
+-- toman el momento de la migración. Fecha sigue siendo la fecha del levantamiento
+UPDATE projects SET created_at = UTC_TIMESTAMP(6), updated_at = UTC_TIMESTAMP(6);
+
+ALTER TABLE projects
+    MODIFY COLUMN created_at DATETIME(6) NOT NULL,
+    MODIFY COLUMN updated_at DATETIME(6) NOT NULL;
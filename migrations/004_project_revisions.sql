@@ -0,0 +1,17 @@
+-- Historial inmutable de cambios de los proyectos. Cada fila guarda la copia completa del proyecto
+-- después del cambio, por lo que cualquier revisión se puede comparar o restaurar.
+-- No tiene clave foránea hacia projects para conservar el historial de los proyectos eliminados.
+
+CREATE TABLE project_revisions (
+    Id INT AUTO_INCREMENT PRIMARY KEY,
+    project_id INT NOT NULL,
+    revision INT NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    editor_id INT NULL,
+    changed_fields JSON NOT NULL,
+    previous_img VARCHAR(500) NULL,
+    restored_from INT NULL,
+    snapshot JSON NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    UNIQUE KEY uq_project_revisions_revision (project_id, revision)
+);
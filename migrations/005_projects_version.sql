@@ -0,0 +1,5 @@
+-- Versión de cada proyecto para el control de concurrencia optimista (ETag / If-Match).
+-- Cada UPDATE incrementa la versión y solo se aplica si coincide con la que conoce el cliente.
+
+ALTER TABLE projects
+    ADD COLUMN version INT NOT NULL DEFAULT 1;
@@ -0,0 +1,83 @@
+-- Catálogo administrado de categorías con slug único y personalizaciones por organización.
+-- Los proyectos referencian la categoría por category_id; Categoria conserva el nombre oficial.
+
+CREATE TABLE categories (
+    Id INT AUTO_INCREMENT PRIMARY KEY,
+    slug VARCHAR(100) NOT NULL,
+    nombre VARCHAR(100) NOT NULL,
+    color CHAR(7) NOT NULL,
+    icon VARCHAR(50) NOT NULL DEFAULT '',
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL,
+    UNIQUE INDEX idx_categories_slug (slug)
+);
+
+CREATE TABLE category_overrides (
+    category_id INT NOT NULL,
+    org_id INT NOT NULL,
+    nombre VARCHAR(100) NOT NULL DEFAULT '',
+    color VARCHAR(7) NOT NULL DEFAULT '',
+    icon VARCHAR(50) NOT NULL DEFAULT '',
+    hidden BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (category_id, org_id),
+    FOREIGN KEY (category_id) REFERENCES categories(Id) ON DELETE CASCADE
+);
+
+ALTER TABLE projects
+    ADD COLUMN category_id INT NULL;
+
+-- Normaliza las categorías existentes. category_slugs relaciona cada valor distinto de Categoria con su slug,
+-- calculado igual que services.Slugify: minúsculas, sin los acentos que quita services.NormalizeText, cada
+-- secuencia de caracteres que no son letras ASCII ni dígitos se reemplaza por un solo guion y se quitan los
+-- guiones de los extremos. La colación binaria evita que [a-z] acepte mayúsculas o letras acentuadas
+CREATE TEMPORARY TABLE category_slugs (
+    Categoria VARCHAR(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL PRIMARY KEY,
+    slug VARCHAR(100) NOT NULL,
+    projects INT NOT NULL,
+    first_id INT NOT NULL
+);
+
+INSERT INTO category_slugs (Categoria, slug, projects, first_id)
+SELECT Categoria,
+    TRIM(BOTH '-' FROM REGEXP_REPLACE(
+        REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(
+            REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(
+                REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(
+                    REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(LOWER(Categoria),
+                'á', 'a'), 'à', 'a'), 'ä', 'a'), 'â', 'a'), 'ã', 'a'), 'é', 'e'),
+                'è', 'e'), 'ë', 'e'), 'ê', 'e'), 'í', 'i'), 'ì', 'i'), 'ï', 'i'),
+                'î', 'i'), 'ó', 'o'), 'ò', 'o'), 'ö', 'o'), 'ô', 'o'), 'õ', 'o'),
+                'ú', 'u'), 'ù', 'u'), 'ü', 'u'), 'û', 'u'), 'ñ', 'n'), 'ç', 'c')),
+        '[^a-z0-9]+', '-')),
+    COUNT(*), MIN(Id)
+FROM (SELECT Categoria COLLATE utf8mb4_bin AS Categoria, Id FROM projects) AS existing
+GROUP BY Categoria;
+
+UPDATE category_slugs SET slug = 'sin-categoria' WHERE slug = '';
+
+-- Las variantes que producen el mismo slug se agrupan en una sola categoría, con el nombre de la variante
+-- que usan más proyectos. Los colores se reparten en orden con la paleta de services.CategoryColor y se
+-- pueden cambiar después con PUT /categories/{id}
+INSERT INTO categories (slug, nombre, color, created_at, updated_at)
+SELECT slug, nombre,
+    CONCAT('#', ELT(1 + MOD(ROW_NUMBER() OVER (ORDER BY slug) - 1, 10),
+        'E6194B', '3CB44B', 'FFE119', '4363D8', 'F58231', '911EB4', '42D4F4', 'F032E6', 'BFEF45', '469990')),
+    UTC_TIMESTAMP(6), UTC_TIMESTAMP(6)
+FROM (
+    SELECT slug, COALESCE(NULLIF(TRIM(Categoria), ''), 'Sin categoría') AS nombre,
+        ROW_NUMBER() OVER (PARTITION BY slug ORDER BY projects DESC, first_id) AS variant_rank
+    FROM category_slugs
+) AS variants
+WHERE variant_rank = 1;
+
+UPDATE projects p
+JOIN category_slugs s ON s.Categoria = p.Categoria COLLATE utf8mb4_bin
+JOIN categories c ON c.slug = s.slug
+SET p.category_id = c.Id, p.Categoria = c.nombre;
+
+DROP TEMPORARY TABLE category_slugs;
+
+ALTER TABLE projects
+    MODIFY COLUMN category_id INT NOT NULL,
+    ADD INDEX idx_category_id (category_id),
+    ADD CONSTRAINT fk_projects_category FOREIGN KEY (category_id) REFERENCES categories(Id);
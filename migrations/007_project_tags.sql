@@ -0,0 +1,20 @@
+-- Etiquetas libres de los proyectos (cliente, temporada, equipo...) en una relación muchos a muchos.
+-- El slug identifica la etiqueta sin distinguir acentos ni mayúsculas.
+
+CREATE TABLE tags (
+    Id INT AUTO_INCREMENT PRIMARY KEY,
+    slug VARCHAR(60) NOT NULL,
+    nombre VARCHAR(50) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    UNIQUE INDEX idx_tags_slug (slug)
+);
+
+CREATE TABLE project_tags (
+    project_id INT NOT NULL,
+    tag_id INT NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (project_id, tag_id),
+    INDEX idx_project_tags_tag (tag_id, project_id),
+    FOREIGN KEY (project_id) REFERENCES projects(Id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(Id) ON DELETE CASCADE
+);
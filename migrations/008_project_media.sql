@@ -0,0 +1,23 @@
+-- Galería de imágenes de cada proyecto: orden, pie de foto, portada y datos de captura.
+-- projects.Img se conserva como la URL de la imagen de portada.
+
+CREATE TABLE project_media (
+    Id INT AUTO_INCREMENT PRIMARY KEY,
+    project_id INT NOT NULL,
+    url VARCHAR(500) NOT NULL,
+    caption VARCHAR(500) NOT NULL DEFAULT '',
+    position INT NOT NULL,
+    is_cover BOOLEAN NOT NULL DEFAULT FALSE,
+    captured_at DATETIME NULL,
+    lat DECIMAL(10, 8) NULL,
+    lng DECIMAL(11, 8) NULL,
+    created_at DATETIME(6) NOT NULL,
+    INDEX idx_project_media_order (project_id, position),
+    FOREIGN KEY (project_id) REFERENCES projects(Id) ON DELETE CASCADE
+);
+
+-- La imagen actual de cada proyecto pasa a ser la portada de su galería
+INSERT INTO project_media (project_id, url, position, is_cover, created_at)
+SELECT Id, Img, 0, TRUE, UTC_TIMESTAMP(6)
+FROM projects
+WHERE Img IS NOT NULL AND Img <> '';
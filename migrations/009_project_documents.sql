@@ -0,0 +1,16 @@
+-- Documentos adjuntos de los proyectos (PDF, DXF, CSV). El archivo vive en el almacenamiento
+-- configurado (Cloudinary o disco local) y location guarda su ubicación en él.
+
+CREATE TABLE project_documents (
+    Id INT AUTO_INCREMENT PRIMARY KEY,
+    project_id INT NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    location VARCHAR(500) NOT NULL,
+    uploaded_by INT NULL,
+    created_at DATETIME(6) NOT NULL,
+    UNIQUE INDEX idx_project_documents_checksum (project_id, checksum),
+    FOREIGN KEY (project_id) REFERENCES projects(Id) ON DELETE CASCADE
+);
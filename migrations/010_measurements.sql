@@ -0,0 +1,21 @@
+-- Puntos de medición levantados en campo para cada proyecto. projects.point_count guarda el
+-- número de puntos para incluirlo en los listados sin contarlos en cada consulta.
+
+CREATE TABLE measurements (
+    Id INT AUTO_INCREMENT PRIMARY KEY,
+    project_id INT NOT NULL,
+    lat DECIMAL(10, 8) NOT NULL,
+    lng DECIMAL(11, 8) NOT NULL,
+    elevation DECIMAL(8, 3) NULL,
+    measured_at DATETIME(6) NOT NULL,
+    instrument VARCHAR(100) NOT NULL DEFAULT '',
+    accuracy DECIMAL(8, 3) NULL,
+    notes VARCHAR(1000) NOT NULL DEFAULT '',
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL,
+    INDEX idx_measurements_project (project_id, Id),
+    FOREIGN KEY (project_id) REFERENCES projects(Id) ON DELETE CASCADE
+);
+
+ALTER TABLE projects
+    ADD COLUMN point_count INT NOT NULL DEFAULT 0;
@@ -0,0 +1,8 @@
+-- Área levantada de cada proyecto: un polígono (parcela) o una línea (trazo) guardados como GeoJSON.
+-- El servidor calcula area_m2 y perimeter_m al guardar la geometría y su centroide se copia a Lat/Lng,
+-- por lo que Ubicacion y las consultas por ubicación siguen funcionando sin cambios.
+
+ALTER TABLE projects
+    ADD COLUMN geometry JSON NULL,
+    ADD COLUMN area_m2 DOUBLE NOT NULL DEFAULT 0,
+    ADD COLUMN perimeter_m DOUBLE NOT NULL DEFAULT 0;
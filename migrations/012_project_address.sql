@@ -0,0 +1,14 @@
+-- Dirección obtenida por geocodificación inversa de la ubicación de cada proyecto.
+-- La escribe en segundo plano el servicio de geocodificación; geocoded_at NULL indica que el proyecto
+-- todavía no se ha geocodificado y las cadenas vacías que el geocodificador no conoce ese nivel.
+
+ALTER TABLE projects
+    ADD COLUMN municipality VARCHAR(150) NOT NULL DEFAULT '',
+    ADD COLUMN state VARCHAR(150) NOT NULL DEFAULT '',
+    ADD COLUMN country VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN country_code CHAR(2) NOT NULL DEFAULT '',
+    ADD COLUMN geocoded_at DATETIME(6) NULL,
+    ADD INDEX idx_projects_country_state_municipality (country, state, municipality),
+    ADD INDEX idx_projects_country_code (country_code),
+    ADD INDEX idx_projects_state (state),
+    ADD INDEX idx_projects_municipality (municipality);
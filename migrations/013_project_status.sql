@@ -0,0 +1,18 @@
+-- Ciclo de vida de los proyectos: estado actual en projects y el historial de transiciones.
+-- Los proyectos existentes quedan como borrador; el flujo de estados permitido lo valida el servidor.
+
+ALTER TABLE projects
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    ADD INDEX idx_projects_status (status);
+
+CREATE TABLE project_status_transitions (
+    Id INT AUTO_INCREMENT PRIMARY KEY,
+    project_id INT NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    comment VARCHAR(1000) NOT NULL DEFAULT '',
+    user_id INT NULL,
+    created_at DATETIME(6) NOT NULL,
+    INDEX idx_status_transitions_project (project_id, Id),
+    FOREIGN KEY (project_id) REFERENCES projects(Id) ON DELETE CASCADE
+);
@@ -0,0 +1,20 @@
+-- Plantillas reutilizables de proyectos y lista de verificación de cada proyecto.
+-- template_id recuerda la plantilla de origen; borrar la plantilla no afecta a los proyectos creados con ella.
+
+CREATE TABLE project_templates (
+    Id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    category_id INT NOT NULL,
+    descripcion TEXT NOT NULL,
+    checklist JSON NULL,
+    user_id INT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL,
+    INDEX idx_project_templates_name (name),
+    FOREIGN KEY (category_id) REFERENCES categories(Id)
+);
+
+ALTER TABLE projects
+    ADD COLUMN checklist JSON NULL,
+    ADD COLUMN template_id INT NULL,
+    ADD CONSTRAINT fk_projects_template FOREIGN KEY (template_id) REFERENCES project_templates(Id) ON DELETE SET NULL;